@@ -4,12 +4,21 @@ import (
 	"exam/product-service/config"
 	"exam/product-service/pkg/logger"
 	service2 "exam/product-service/service"
+	"os"
 )
 
 func main() {
 	cfg := config.Load()
 
 	log := logger.New(cfg.Environment, "product-service")
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, log, os.Args[2:]); err != nil {
+			log.Fatal("error while migrating", logger.Error(err))
+		}
+		return
+	}
+
 	service, err := service2.New(cfg, log)
 	if err != nil {
 		log.Error("error while accessing services", logger.Error(err))
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"exam/product-service/config"
+	"exam/product-service/pkg/logger"
+	"exam/product-service/storage"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const migrateUsage = "usage: main migrate up | down | to <version> | force <version> | status"
+
+// runMigrate handles `main migrate ...` against the configured storage driver
+func runMigrate(cfg *config.Config, log logger.Logger, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	migrator, closeConn, err := storage.Migrator(*cfg, log)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		return migrator.Down(ctx)
+	case "to", "force":
+		if len(args) != 2 {
+			return errors.New(migrateUsage)
+		}
+
+		version, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q: %w", args[1], err)
+		}
+
+		if args[0] == "force" {
+			return migrator.Force(ctx, uint(version))
+		}
+		return migrator.To(ctx, uint(version))
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stdout, "%s at version %d (dirty: %t)\n", cfg.StorageDriver, status.Version, status.Dirty)
+		for _, m := range status.Migrations {
+			state := "pending"
+			if m.Applied {
+				state = "applied"
+			}
+			fmt.Fprintf(os.Stdout, "%06d %-40s %s\n", m.Version, m.Name, state)
+		}
+
+		return nil
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/spf13/cast"
 )
@@ -9,11 +10,16 @@ import (
 // Config ...
 type Config struct {
 	Environment      string // develop, staging, production
+	StorageDriver    string // postgres, mongo, memory
 	PostgresHost     string
 	PostgresPort     int
 	PostgresDatabase string
 	PostgresUser     string
 	PostgresPassword string
+	MongoURI         string
+	MongoDatabase    string
+	MigrateOnStart   bool          // apply pending schema migrations when the service boots
+	SweepInterval    time.Duration // how often expired stock reservations are released
 	LogLevel         string
 	RPCPort          string
 	// PostServiceHost  string
@@ -25,12 +31,21 @@ func Load() *Config {
 
 	c.Environment = cast.ToString(getOrReturnDefault("ENVIRONMENT", "develop"))
 
+	c.StorageDriver = cast.ToString(getOrReturnDefault("STORAGE_DRIVER", "mongo"))
+
 	c.PostgresHost = cast.ToString(getOrReturnDefault("POSTGRES_HOST", "localhost"))
 	c.PostgresPort = cast.ToInt(getOrReturnDefault("POSTGRES_PORT", 5432))
 	c.PostgresDatabase = cast.ToString(getOrReturnDefault("POSTGRES_DATABASE", "exam"))
 	c.PostgresUser = cast.ToString(getOrReturnDefault("POSTGRES_USER", "postgres"))
 	c.PostgresPassword = cast.ToString(getOrReturnDefault("POSTGRES_PASSWORD", "mubina2007"))
 
+	c.MongoURI = cast.ToString(getOrReturnDefault("MONGO_URI", "mongodb://mongodb:27017"))
+	c.MongoDatabase = cast.ToString(getOrReturnDefault("MONGO_DATABASE", "productdb"))
+
+	c.MigrateOnStart = cast.ToBool(getOrReturnDefault("MIGRATE_ON_START", false))
+
+	c.SweepInterval = cast.ToDuration(getOrReturnDefault("RESERVATION_SWEEP_INTERVAL", "1m"))
+
 	c.LogLevel = cast.ToString(getOrReturnDefault("LOG_LEVEL", "debug"))
 
 	c.RPCPort = cast.ToString(getOrReturnDefault("RPC_PORT", ":5050"))
@@ -39,9 +54,9 @@ func Load() *Config {
 }
 
 func getOrReturnDefault(key string, defaultValue interface{}) interface{} {
-	_, exists := os.LookupEnv(key)
+	value, exists := os.LookupEnv(key)
 	if exists {
-		return defaultValue
+		return value
 	}
 
 	return defaultValue
@@ -7,6 +7,7 @@ import (
 	context "context"
 	encoding_binary "encoding/binary"
 	fmt "fmt"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -27,15 +28,203 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type SortField int32
+
+const (
+	SortField_SORT_ID         SortField = 0
+	SortField_SORT_PRICE      SortField = 1
+	SortField_SORT_NAME       SortField = 2
+	SortField_SORT_CREATED_AT SortField = 3
+	SortField_SORT_AMOUNT     SortField = 4
+)
+
+var SortField_name = map[int32]string{
+	0: "SORT_ID",
+	1: "SORT_PRICE",
+	2: "SORT_NAME",
+	3: "SORT_CREATED_AT",
+	4: "SORT_AMOUNT",
+}
+
+var SortField_value = map[string]int32{
+	"SORT_ID":         0,
+	"SORT_PRICE":      1,
+	"SORT_NAME":       2,
+	"SORT_CREATED_AT": 3,
+	"SORT_AMOUNT":     4,
+}
+
+func (x SortField) String() string {
+	return proto.EnumName(SortField_name, int32(x))
+}
+
+func (SortField) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{0}
+}
+
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_ACTIVE    ReservationStatus = 0
+	ReservationStatus_RESERVATION_COMMITTED ReservationStatus = 1
+	ReservationStatus_RESERVATION_RELEASED  ReservationStatus = 2
+	// Released by the sweeper once expires_at passed.
+	ReservationStatus_RESERVATION_EXPIRED ReservationStatus = 3
+)
+
+var ReservationStatus_name = map[int32]string{
+	0: "RESERVATION_ACTIVE",
+	1: "RESERVATION_COMMITTED",
+	2: "RESERVATION_RELEASED",
+	3: "RESERVATION_EXPIRED",
+}
+
+var ReservationStatus_value = map[string]int32{
+	"RESERVATION_ACTIVE":    0,
+	"RESERVATION_COMMITTED": 1,
+	"RESERVATION_RELEASED":  2,
+	"RESERVATION_EXPIRED":   3,
+}
+
+func (x ReservationStatus) String() string {
+	return proto.EnumName(ReservationStatus_name, int32(x))
+}
+
+func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{1}
+}
+
+// Orders start out pending. Legal moves: pending -> paid | cancelled,
+// paid -> shipped | cancelled, shipped -> delivered, delivered -> refunded.
+// Cancelling puts the stock of the order back.
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_PENDING   OrderStatus = 0
+	OrderStatus_ORDER_PAID      OrderStatus = 1
+	OrderStatus_ORDER_SHIPPED   OrderStatus = 2
+	OrderStatus_ORDER_DELIVERED OrderStatus = 3
+	OrderStatus_ORDER_CANCELLED OrderStatus = 4
+	OrderStatus_ORDER_REFUNDED  OrderStatus = 5
+)
+
+var OrderStatus_name = map[int32]string{
+	0: "ORDER_PENDING",
+	1: "ORDER_PAID",
+	2: "ORDER_SHIPPED",
+	3: "ORDER_DELIVERED",
+	4: "ORDER_CANCELLED",
+	5: "ORDER_REFUNDED",
+}
+
+var OrderStatus_value = map[string]int32{
+	"ORDER_PENDING":   0,
+	"ORDER_PAID":      1,
+	"ORDER_SHIPPED":   2,
+	"ORDER_DELIVERED": 3,
+	"ORDER_CANCELLED": 4,
+	"ORDER_REFUNDED":  5,
+}
+
+func (x OrderStatus) String() string {
+	return proto.EnumName(OrderStatus_name, int32(x))
+}
+
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{2}
+}
+
+type ReturnReason int32
+
+const (
+	ReturnReason_RETURN_OTHER            ReturnReason = 0
+	ReturnReason_RETURN_DAMAGED          ReturnReason = 1
+	ReturnReason_RETURN_WRONG_ITEM       ReturnReason = 2
+	ReturnReason_RETURN_NOT_AS_DESCRIBED ReturnReason = 3
+	ReturnReason_RETURN_CHANGED_MIND     ReturnReason = 4
+)
+
+var ReturnReason_name = map[int32]string{
+	0: "RETURN_OTHER",
+	1: "RETURN_DAMAGED",
+	2: "RETURN_WRONG_ITEM",
+	3: "RETURN_NOT_AS_DESCRIBED",
+	4: "RETURN_CHANGED_MIND",
+}
+
+var ReturnReason_value = map[string]int32{
+	"RETURN_OTHER":            0,
+	"RETURN_DAMAGED":          1,
+	"RETURN_WRONG_ITEM":       2,
+	"RETURN_NOT_AS_DESCRIBED": 3,
+	"RETURN_CHANGED_MIND":     4,
+}
+
+func (x ReturnReason) String() string {
+	return proto.EnumName(ReturnReason_name, int32(x))
+}
+
+func (ReturnReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{3}
+}
+
+type StockMovementReason int32
+
+const (
+	// The amount a product was created with, or had when the ledger started.
+	StockMovementReason_MOVEMENT_OPENING  StockMovementReason = 0
+	StockMovementReason_MOVEMENT_INCREASE StockMovementReason = 1
+	StockMovementReason_MOVEMENT_DECREASE StockMovementReason = 2
+	// UpdateProduct or PatchProduct set a new amount.
+	StockMovementReason_MOVEMENT_ADJUSTMENT   StockMovementReason = 3
+	StockMovementReason_MOVEMENT_SALE         StockMovementReason = 4
+	StockMovementReason_MOVEMENT_CANCELLATION StockMovementReason = 5
+	StockMovementReason_MOVEMENT_RETURN       StockMovementReason = 6
+	StockMovementReason_MOVEMENT_RESERVATION  StockMovementReason = 7
+)
+
+var StockMovementReason_name = map[int32]string{
+	0: "MOVEMENT_OPENING",
+	1: "MOVEMENT_INCREASE",
+	2: "MOVEMENT_DECREASE",
+	3: "MOVEMENT_ADJUSTMENT",
+	4: "MOVEMENT_SALE",
+	5: "MOVEMENT_CANCELLATION",
+	6: "MOVEMENT_RETURN",
+	7: "MOVEMENT_RESERVATION",
+}
+
+var StockMovementReason_value = map[string]int32{
+	"MOVEMENT_OPENING":      0,
+	"MOVEMENT_INCREASE":     1,
+	"MOVEMENT_DECREASE":     2,
+	"MOVEMENT_ADJUSTMENT":   3,
+	"MOVEMENT_SALE":         4,
+	"MOVEMENT_CANCELLATION": 5,
+	"MOVEMENT_RETURN":       6,
+	"MOVEMENT_RESERVATION":  7,
+}
+
+func (x StockMovementReason) String() string {
+	return proto.EnumName(StockMovementReason_name, int32(x))
+}
+
+func (StockMovementReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{4}
+}
+
 type Product struct {
-	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
-	Price                float32  `protobuf:"fixed32,4,opt,name=price,proto3" json:"price"`
-	Amount               int32    `protobuf:"varint,5,opt,name=amount,proto3" json:"amount"`
-	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	Deleted              string   `protobuf:"bytes,8,opt,name=deleted,proto3" json:"deleted"`
+	Id          int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	Price       float32 `protobuf:"fixed32,4,opt,name=price,proto3" json:"price"`
+	Amount      int32   `protobuf:"varint,5,opt,name=amount,proto3" json:"amount"`
+	CreatedAt   string  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt   string  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	Deleted     string  `protobuf:"bytes,8,opt,name=deleted,proto3" json:"deleted"`
+	// Incremented on every write; send it back in UpdateProduct or
+	// PatchProduct to reject concurrent edits. Zero skips the check.
+	Version              int64    `protobuf:"varint,9,opt,name=version,proto3" json:"version"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -130,8 +319,72 @@ func (m *Product) GetDeleted() string {
 	return ""
 }
 
+func (m *Product) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type UpdateProductRequest struct {
+	Product *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product"`
+	// Fields of product to write; an empty mask writes every field.
+	UpdateMask           *types.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateProductRequest) Reset()         { *m = UpdateProductRequest{} }
+func (m *UpdateProductRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProductRequest) ProtoMessage()    {}
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{1}
+}
+func (m *UpdateProductRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateProductRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateProductRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateProductRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProductRequest.Merge(m, src)
+}
+func (m *UpdateProductRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateProductRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProductRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProductRequest proto.InternalMessageInfo
+
+func (m *UpdateProductRequest) GetProduct() *Product {
+	if m != nil {
+		return m.Product
+	}
+	return nil
+}
+
+func (m *UpdateProductRequest) GetUpdateMask() *types.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
 type GetProductId struct {
 	ProductId            int32    `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	IncludeDeleted       bool     `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -141,7 +394,7 @@ func (m *GetProductId) Reset()         { *m = GetProductId{} }
 func (m *GetProductId) String() string { return proto.CompactTextString(m) }
 func (*GetProductId) ProtoMessage()    {}
 func (*GetProductId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6245fd25d14268cd, []int{1}
+	return fileDescriptor_6245fd25d14268cd, []int{2}
 }
 func (m *GetProductId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,19 +430,50 @@ func (m *GetProductId) GetProductId() int32 {
 	return 0
 }
 
+func (m *GetProductId) GetIncludeDeleted() bool {
+	if m != nil {
+		return m.IncludeDeleted
+	}
+	return false
+}
+
 type GetListRequest struct {
-	Page                 int32    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Page           int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit          int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	IncludeDeleted bool  `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted"`
+	// Only products in this category or any of its descendants; 0 lists all.
+	CategoryId int32 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id"`
+	// Case-insensitive substring of the product name.
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name"`
+	// Inclusive price bounds; 0 leaves a bound open.
+	MinPrice float32 `protobuf:"fixed32,6,opt,name=min_price,json=minPrice,proto3" json:"min_price"`
+	MaxPrice float32 `protobuf:"fixed32,7,opt,name=max_price,json=maxPrice,proto3" json:"max_price"`
+	// Only products with a positive amount.
+	InStock bool `protobuf:"varint,8,opt,name=in_stock,json=inStock,proto3" json:"in_stock"`
+	// RFC 3339 bounds on created_at, after inclusive and before exclusive;
+	// empty leaves a bound open.
+	CreatedAfter  string `protobuf:"bytes,9,opt,name=created_after,json=createdAfter,proto3" json:"created_after"`
+	CreatedBefore string `protobuf:"bytes,10,opt,name=created_before,json=createdBefore,proto3" json:"created_before"`
+	// Ties are broken by id in the same direction.
+	SortBy     SortField `protobuf:"varint,11,opt,name=sort_by,json=sortBy,proto3,enum=product.SortField" json:"sort_by"`
+	Descending bool      `protobuf:"varint,12,opt,name=descending,proto3" json:"descending"`
+	// next_page_token of a previous response with the same sort order;
+	// when set, page is ignored and the list continues after that product.
+	PageToken string `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token"`
+	// Aggregate facets over every product matching the filters.
+	IncludeFacets bool `protobuf:"varint,14,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets"`
+	// Ascending upper bounds of the price buckets; empty uses the defaults.
+	PriceBounds          []float32 `protobuf:"fixed32,15,rep,packed,name=price_bounds,json=priceBounds,proto3" json:"price_bounds"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *GetListRequest) Reset()         { *m = GetListRequest{} }
 func (m *GetListRequest) String() string { return proto.CompactTextString(m) }
 func (*GetListRequest) ProtoMessage()    {}
 func (*GetListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6245fd25d14268cd, []int{2}
+	return fileDescriptor_6245fd25d14268cd, []int{3}
 }
 func (m *GetListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,19 +516,119 @@ func (m *GetListRequest) GetLimit() int32 {
 	return 0
 }
 
+func (m *GetListRequest) GetIncludeDeleted() bool {
+	if m != nil {
+		return m.IncludeDeleted
+	}
+	return false
+}
+
+func (m *GetListRequest) GetCategoryId() int32 {
+	if m != nil {
+		return m.CategoryId
+	}
+	return 0
+}
+
+func (m *GetListRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetListRequest) GetMinPrice() float32 {
+	if m != nil {
+		return m.MinPrice
+	}
+	return 0
+}
+
+func (m *GetListRequest) GetMaxPrice() float32 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *GetListRequest) GetInStock() bool {
+	if m != nil {
+		return m.InStock
+	}
+	return false
+}
+
+func (m *GetListRequest) GetCreatedAfter() string {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return ""
+}
+
+func (m *GetListRequest) GetCreatedBefore() string {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return ""
+}
+
+func (m *GetListRequest) GetSortBy() SortField {
+	if m != nil {
+		return m.SortBy
+	}
+	return SortField_SORT_ID
+}
+
+func (m *GetListRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *GetListRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *GetListRequest) GetIncludeFacets() bool {
+	if m != nil {
+		return m.IncludeFacets
+	}
+	return false
+}
+
+func (m *GetListRequest) GetPriceBounds() []float32 {
+	if m != nil {
+		return m.PriceBounds
+	}
+	return nil
+}
+
 type GetListResponse struct {
-	Count                int64      `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	Products             []*Product `protobuf:"bytes,2,rep,name=products,proto3" json:"products"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	// number of products on this page
+	Count    int64      `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Products []*Product `protobuf:"bytes,2,rep,name=products,proto3" json:"products"`
+	// number of products matching the filters across all pages
+	TotalCount int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count"`
+	TotalPages int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages"`
+	HasNext    bool  `protobuf:"varint,5,opt,name=has_next,json=hasNext,proto3" json:"has_next"`
+	// Opaque token for the page after this one; empty on the last page.
+	NextPageToken string `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token"`
+	// Set when include_facets was requested.
+	Facets               *Facets  `protobuf:"bytes,7,opt,name=facets,proto3" json:"facets"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetListResponse) Reset()         { *m = GetListResponse{} }
 func (m *GetListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetListResponse) ProtoMessage()    {}
 func (*GetListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6245fd25d14268cd, []int{3}
+	return fileDescriptor_6245fd25d14268cd, []int{4}
 }
 func (m *GetListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,73 +671,65 @@ func (m *GetListResponse) GetProducts() []*Product {
 	return nil
 }
 
-type Status struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetListResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
 }
 
-func (m *Status) Reset()         { *m = Status{} }
-func (m *Status) String() string { return proto.CompactTextString(m) }
-func (*Status) ProtoMessage()    {}
-func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6245fd25d14268cd, []int{4}
-}
-func (m *Status) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Status.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *GetListResponse) GetTotalPages() int32 {
+	if m != nil {
+		return m.TotalPages
 	}
+	return 0
 }
-func (m *Status) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Status.Merge(m, src)
-}
-func (m *Status) XXX_Size() int {
-	return m.Size()
-}
-func (m *Status) XXX_DiscardUnknown() {
-	xxx_messageInfo_Status.DiscardUnknown(m)
+
+func (m *GetListResponse) GetHasNext() bool {
+	if m != nil {
+		return m.HasNext
+	}
+	return false
 }
 
-var xxx_messageInfo_Status proto.InternalMessageInfo
+func (m *GetListResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
 
-func (m *Status) GetSuccess() bool {
+func (m *GetListResponse) GetFacets() *Facets {
 	if m != nil {
-		return m.Success
+		return m.Facets
 	}
-	return false
+	return nil
 }
 
-type ProductAmountRequest struct {
-	ProductId            int32    `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
-	AmountBy             int32    `protobuf:"varint,2,opt,name=amount_by,json=amountBy,proto3" json:"amount_by"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Facets struct {
+	// Products per directly assigned category, most products first.
+	Categories []*CategoryFacet `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"`
+	// One bucket per price range, in ascending order, including empty ones.
+	PriceBuckets         []*PriceBucket `protobuf:"bytes,2,rep,name=price_buckets,json=priceBuckets,proto3" json:"price_buckets"`
+	InStock              int64          `protobuf:"varint,3,opt,name=in_stock,json=inStock,proto3" json:"in_stock"`
+	OutOfStock           int64          `protobuf:"varint,4,opt,name=out_of_stock,json=outOfStock,proto3" json:"out_of_stock"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ProductAmountRequest) Reset()         { *m = ProductAmountRequest{} }
-func (m *ProductAmountRequest) String() string { return proto.CompactTextString(m) }
-func (*ProductAmountRequest) ProtoMessage()    {}
-func (*ProductAmountRequest) Descriptor() ([]byte, []int) {
+func (m *Facets) Reset()         { *m = Facets{} }
+func (m *Facets) String() string { return proto.CompactTextString(m) }
+func (*Facets) ProtoMessage()    {}
+func (*Facets) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6245fd25d14268cd, []int{5}
 }
-func (m *ProductAmountRequest) XXX_Unmarshal(b []byte) error {
+func (m *Facets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProductAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Facets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProductAmountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Facets.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -363,32 +739,285 @@ func (m *ProductAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ProductAmountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProductAmountRequest.Merge(m, src)
+func (m *Facets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Facets.Merge(m, src)
 }
-func (m *ProductAmountRequest) XXX_Size() int {
+func (m *Facets) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProductAmountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProductAmountRequest.DiscardUnknown(m)
+func (m *Facets) XXX_DiscardUnknown() {
+	xxx_messageInfo_Facets.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProductAmountRequest proto.InternalMessageInfo
+var xxx_messageInfo_Facets proto.InternalMessageInfo
 
-func (m *ProductAmountRequest) GetProductId() int32 {
+func (m *Facets) GetCategories() []*CategoryFacet {
 	if m != nil {
-		return m.ProductId
+		return m.Categories
 	}
-	return 0
+	return nil
 }
 
-func (m *ProductAmountRequest) GetAmountBy() int32 {
+func (m *Facets) GetPriceBuckets() []*PriceBucket {
+	if m != nil {
+		return m.PriceBuckets
+	}
+	return nil
+}
+
+func (m *Facets) GetInStock() int64 {
+	if m != nil {
+		return m.InStock
+	}
+	return 0
+}
+
+func (m *Facets) GetOutOfStock() int64 {
+	if m != nil {
+		return m.OutOfStock
+	}
+	return 0
+}
+
+type CategoryFacet struct {
+	CategoryId           int32    `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CategoryFacet) Reset()         { *m = CategoryFacet{} }
+func (m *CategoryFacet) String() string { return proto.CompactTextString(m) }
+func (*CategoryFacet) ProtoMessage()    {}
+func (*CategoryFacet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{6}
+}
+func (m *CategoryFacet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CategoryFacet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CategoryFacet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CategoryFacet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoryFacet.Merge(m, src)
+}
+func (m *CategoryFacet) XXX_Size() int {
+	return m.Size()
+}
+func (m *CategoryFacet) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoryFacet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoryFacet proto.InternalMessageInfo
+
+func (m *CategoryFacet) GetCategoryId() int32 {
+	if m != nil {
+		return m.CategoryId
+	}
+	return 0
+}
+
+func (m *CategoryFacet) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CategoryFacet) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type PriceBucket struct {
+	// min is inclusive and max exclusive; the last bucket has no max and
+	// reports 0.
+	Min                  float32  `protobuf:"fixed32,1,opt,name=min,proto3" json:"min"`
+	Max                  float32  `protobuf:"fixed32,2,opt,name=max,proto3" json:"max"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceBucket) Reset()         { *m = PriceBucket{} }
+func (m *PriceBucket) String() string { return proto.CompactTextString(m) }
+func (*PriceBucket) ProtoMessage()    {}
+func (*PriceBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{7}
+}
+func (m *PriceBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceBucket.Merge(m, src)
+}
+func (m *PriceBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceBucket proto.InternalMessageInfo
+
+func (m *PriceBucket) GetMin() float32 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *PriceBucket) GetMax() float32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *PriceBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type Status struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Status) Reset()         { *m = Status{} }
+func (m *Status) String() string { return proto.CompactTextString(m) }
+func (*Status) ProtoMessage()    {}
+func (*Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{8}
+}
+func (m *Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Status.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Status.Merge(m, src)
+}
+func (m *Status) XXX_Size() int {
+	return m.Size()
+}
+func (m *Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Status proto.InternalMessageInfo
+
+func (m *Status) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type ProductAmountRequest struct {
+	ProductId int32 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	AmountBy  int32 `protobuf:"varint,2,opt,name=amount_by,json=amountBy,proto3" json:"amount_by"`
+	// When non-zero the change only applies to this product version.
+	ExpectedVersion      int64    `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProductAmountRequest) Reset()         { *m = ProductAmountRequest{} }
+func (m *ProductAmountRequest) String() string { return proto.CompactTextString(m) }
+func (*ProductAmountRequest) ProtoMessage()    {}
+func (*ProductAmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{9}
+}
+func (m *ProductAmountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProductAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProductAmountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProductAmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductAmountRequest.Merge(m, src)
+}
+func (m *ProductAmountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProductAmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductAmountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProductAmountRequest proto.InternalMessageInfo
+
+func (m *ProductAmountRequest) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
+	}
+	return 0
+}
+
+func (m *ProductAmountRequest) GetAmountBy() int32 {
 	if m != nil {
 		return m.AmountBy
 	}
 	return 0
 }
 
+func (m *ProductAmountRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
 type ProductAmountResponse struct {
 	IsEnough             bool     `protobuf:"varint,1,opt,name=is_enough,json=isEnough,proto3" json:"is_enough"`
 	Product              *Product `protobuf:"bytes,2,opt,name=product,proto3" json:"product"`
@@ -401,7 +1030,7 @@ func (m *ProductAmountResponse) Reset()         { *m = ProductAmountResponse{} }
 func (m *ProductAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*ProductAmountResponse) ProtoMessage()    {}
 func (*ProductAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6245fd25d14268cd, []int{6}
+	return fileDescriptor_6245fd25d14268cd, []int{10}
 }
 func (m *ProductAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,8 +1074,10 @@ func (m *ProductAmountResponse) GetProduct() *Product {
 }
 
 type CheckAmountResponse struct {
-	ProductId            int32    `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	ProductId int32 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	// Available stock: the amount on hand minus what active reservations hold.
 	Amount               int32    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount"`
+	Reserved             int32    `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -456,7 +1087,7 @@ func (m *CheckAmountResponse) Reset()         { *m = CheckAmountResponse{} }
 func (m *CheckAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckAmountResponse) ProtoMessage()    {}
 func (*CheckAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6245fd25d14268cd, []int{7}
+	return fileDescriptor_6245fd25d14268cd, []int{11}
 }
 func (m *CheckAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,27 +1130,38 @@ func (m *CheckAmountResponse) GetAmount() int32 {
 	return 0
 }
 
-type BuyProductRequest struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	ProductId            int32    `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id"`
-	Amount               int32    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount"`
+func (m *CheckAmountResponse) GetReserved() int32 {
+	if m != nil {
+		return m.Reserved
+	}
+	return 0
+}
+
+// ReserveStock holds quantity of a product for a checkout. Held stock is not
+// available to anyone else until the reservation is committed, which takes it
+// from stock for good, released or expires.
+type ReserveStockRequest struct {
+	ProductId int32 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Quantity  int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity"`
+	// How long the hold lasts; 0 uses the default of 15 minutes.
+	TtlSeconds           int32    `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BuyProductRequest) Reset()         { *m = BuyProductRequest{} }
-func (m *BuyProductRequest) String() string { return proto.CompactTextString(m) }
-func (*BuyProductRequest) ProtoMessage()    {}
-func (*BuyProductRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6245fd25d14268cd, []int{8}
+func (m *ReserveStockRequest) Reset()         { *m = ReserveStockRequest{} }
+func (m *ReserveStockRequest) String() string { return proto.CompactTextString(m) }
+func (*ReserveStockRequest) ProtoMessage()    {}
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{12}
 }
-func (m *BuyProductRequest) XXX_Unmarshal(b []byte) error {
+func (m *ReserveStockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BuyProductRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReserveStockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BuyProductRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReserveStockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -529,58 +1171,63 @@ func (m *BuyProductRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *BuyProductRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuyProductRequest.Merge(m, src)
+func (m *ReserveStockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveStockRequest.Merge(m, src)
 }
-func (m *BuyProductRequest) XXX_Size() int {
+func (m *ReserveStockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BuyProductRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuyProductRequest.DiscardUnknown(m)
+func (m *ReserveStockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveStockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuyProductRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReserveStockRequest proto.InternalMessageInfo
 
-func (m *BuyProductRequest) GetUserId() string {
+func (m *ReserveStockRequest) GetProductId() int32 {
 	if m != nil {
-		return m.UserId
+		return m.ProductId
 	}
-	return ""
+	return 0
 }
 
-func (m *BuyProductRequest) GetProductId() int32 {
+func (m *ReserveStockRequest) GetQuantity() int32 {
 	if m != nil {
-		return m.ProductId
+		return m.Quantity
 	}
 	return 0
 }
 
-func (m *BuyProductRequest) GetAmount() int32 {
+func (m *ReserveStockRequest) GetTtlSeconds() int32 {
 	if m != nil {
-		return m.Amount
+		return m.TtlSeconds
 	}
 	return 0
 }
 
-type GetUserID struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Reservation struct {
+	Id                   int32             `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	ProductId            int32             `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Quantity             int32             `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity"`
+	Status               ReservationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=product.ReservationStatus" json:"status"`
+	ExpiresAt            string            `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
+	CreatedAt            string            `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetUserID) Reset()         { *m = GetUserID{} }
-func (m *GetUserID) String() string { return proto.CompactTextString(m) }
-func (*GetUserID) ProtoMessage()    {}
-func (*GetUserID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6245fd25d14268cd, []int{9}
+func (m *Reservation) Reset()         { *m = Reservation{} }
+func (m *Reservation) String() string { return proto.CompactTextString(m) }
+func (*Reservation) ProtoMessage()    {}
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{13}
 }
-func (m *GetUserID) XXX_Unmarshal(b []byte) error {
+func (m *Reservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUserID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Reservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUserID.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Reservation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -590,44 +1237,79 @@ func (m *GetUserID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GetUserID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserID.Merge(m, src)
+func (m *Reservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reservation.Merge(m, src)
 }
-func (m *GetUserID) XXX_Size() int {
+func (m *Reservation) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUserID) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserID.DiscardUnknown(m)
+func (m *Reservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reservation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserID proto.InternalMessageInfo
+var xxx_messageInfo_Reservation proto.InternalMessageInfo
 
-func (m *GetUserID) GetUserId() string {
+func (m *Reservation) GetId() int32 {
 	if m != nil {
-		return m.UserId
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Reservation) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
+	}
+	return 0
+}
+
+func (m *Reservation) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Reservation) GetStatus() ReservationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ReservationStatus_RESERVATION_ACTIVE
+}
+
+func (m *Reservation) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
 	}
 	return ""
 }
 
-type GetPurchasedProductsResponse struct {
-	Products             []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *Reservation) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
 }
 
-func (m *GetPurchasedProductsResponse) Reset()         { *m = GetPurchasedProductsResponse{} }
-func (m *GetPurchasedProductsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPurchasedProductsResponse) ProtoMessage()    {}
-func (*GetPurchasedProductsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6245fd25d14268cd, []int{10}
+type GetReservationId struct {
+	ReservationId        int32    `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetPurchasedProductsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *GetReservationId) Reset()         { *m = GetReservationId{} }
+func (m *GetReservationId) String() string { return proto.CompactTextString(m) }
+func (*GetReservationId) ProtoMessage()    {}
+func (*GetReservationId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{14}
+}
+func (m *GetReservationId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetPurchasedProductsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetReservationId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetPurchasedProductsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetReservationId.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -637,1201 +1319,13165 @@ func (m *GetPurchasedProductsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetPurchasedProductsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPurchasedProductsResponse.Merge(m, src)
+func (m *GetReservationId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReservationId.Merge(m, src)
 }
-func (m *GetPurchasedProductsResponse) XXX_Size() int {
+func (m *GetReservationId) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetPurchasedProductsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPurchasedProductsResponse.DiscardUnknown(m)
+func (m *GetReservationId) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReservationId.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPurchasedProductsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetReservationId proto.InternalMessageInfo
 
-func (m *GetPurchasedProductsResponse) GetProducts() []*Product {
+func (m *GetReservationId) GetReservationId() int32 {
 	if m != nil {
-		return m.Products
+		return m.ReservationId
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*Product)(nil), "product.Product")
-	proto.RegisterType((*GetProductId)(nil), "product.GetProductId")
-	proto.RegisterType((*GetListRequest)(nil), "product.GetListRequest")
-	proto.RegisterType((*GetListResponse)(nil), "product.GetListResponse")
-	proto.RegisterType((*Status)(nil), "product.Status")
-	proto.RegisterType((*ProductAmountRequest)(nil), "product.ProductAmountRequest")
-	proto.RegisterType((*ProductAmountResponse)(nil), "product.ProductAmountResponse")
-	proto.RegisterType((*CheckAmountResponse)(nil), "product.CheckAmountResponse")
-	proto.RegisterType((*BuyProductRequest)(nil), "product.BuyProductRequest")
-	proto.RegisterType((*GetUserID)(nil), "product.GetUserID")
-	proto.RegisterType((*GetPurchasedProductsResponse)(nil), "product.GetPurchasedProductsResponse")
+// BuyProduct places an order with a single line.
+type BuyProductRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	ProductId            int32    `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Amount               int32    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("product-service/product.proto", fileDescriptor_6245fd25d14268cd) }
-
-var fileDescriptor_6245fd25d14268cd = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4b, 0x6b, 0xdb, 0x40,
-	0x10, 0x96, 0xe4, 0xf8, 0xa1, 0xc9, 0xb3, 0xdb, 0xa4, 0x11, 0x4e, 0x6c, 0xcc, 0xd2, 0x82, 0x29,
-	0x4d, 0x0a, 0x29, 0x39, 0xf4, 0x71, 0xf1, 0xa3, 0x18, 0x83, 0x0f, 0x45, 0xc1, 0x50, 0xe8, 0xc1,
-	0x55, 0xa4, 0x25, 0x16, 0x8d, 0x25, 0x55, 0xbb, 0x2a, 0xf8, 0x9f, 0xf4, 0x27, 0xf5, 0x98, 0x4b,
-	0xef, 0xc5, 0xfd, 0x23, 0x45, 0xfb, 0x50, 0xe4, 0x87, 0x1a, 0x7a, 0xe8, 0x6d, 0x67, 0x66, 0xe7,
-	0xdb, 0xf9, 0x46, 0xdf, 0x67, 0x43, 0x23, 0x8a, 0x43, 0x2f, 0x71, 0xd9, 0x19, 0x25, 0xf1, 0x37,
-	0xdf, 0x25, 0x2f, 0x65, 0x7c, 0x1e, 0xc5, 0x21, 0x0b, 0x51, 0x55, 0x86, 0xf8, 0xa7, 0x0e, 0xd5,
-	0x0f, 0xe2, 0x8c, 0xf6, 0xc0, 0xf0, 0x3d, 0x4b, 0x6f, 0xe9, 0xed, 0xb2, 0x6d, 0xf8, 0x1e, 0x42,
-	0xb0, 0x15, 0x38, 0x33, 0x62, 0x19, 0x2d, 0xbd, 0x6d, 0xda, 0xfc, 0x8c, 0x5a, 0xb0, 0xed, 0x11,
-	0xea, 0xc6, 0x7e, 0xc4, 0xfc, 0x30, 0xb0, 0x4a, 0xbc, 0x94, 0x4f, 0xa1, 0x43, 0x28, 0x47, 0xb1,
-	0xef, 0x12, 0x6b, 0xab, 0xa5, 0xb7, 0x0d, 0x5b, 0x04, 0xe8, 0x09, 0x54, 0x9c, 0x59, 0x98, 0x04,
-	0xcc, 0x2a, 0x73, 0x7c, 0x19, 0xa1, 0x06, 0x80, 0x1b, 0x13, 0x87, 0x11, 0x6f, 0xe2, 0x30, 0xab,
-	0xc2, 0xe1, 0x4c, 0x99, 0xe9, 0xf0, 0x72, 0x12, 0x79, 0xaa, 0x5c, 0x15, 0x65, 0x99, 0xe9, 0x30,
-	0x64, 0x41, 0xd5, 0x23, 0xb7, 0x84, 0x11, 0xcf, 0xaa, 0xf1, 0x9a, 0x0a, 0xf1, 0x19, 0xec, 0x0c,
-	0x08, 0x93, 0xcc, 0x86, 0x5e, 0x0a, 0x24, 0x29, 0x4f, 0x32, 0x8e, 0x66, 0xa4, 0xca, 0xf8, 0x0d,
-	0xec, 0x0d, 0x08, 0x1b, 0xf9, 0x94, 0xd9, 0xe4, 0x6b, 0x42, 0x28, 0x4b, 0xc9, 0x47, 0xce, 0x0d,
-	0x91, 0x57, 0xf9, 0x39, 0xa5, 0x76, 0xeb, 0xcf, 0x7c, 0xc6, 0x37, 0x52, 0xb6, 0x45, 0x80, 0xc7,
-	0xb0, 0x9f, 0xf5, 0xd2, 0x28, 0x0c, 0x28, 0xbf, 0xe8, 0x72, 0xb2, 0x69, 0x77, 0xc9, 0x16, 0x01,
-	0x7a, 0x01, 0x35, 0xf9, 0x22, 0xb5, 0x8c, 0x56, 0xa9, 0xbd, 0x7d, 0x71, 0x70, 0xae, 0x3e, 0x8b,
-	0x9c, 0xd4, 0xce, 0x6e, 0x60, 0x0c, 0x95, 0x2b, 0xe6, 0xb0, 0x84, 0xa6, 0x2c, 0x69, 0xe2, 0xba,
-	0x84, 0x52, 0x8e, 0x57, 0xb3, 0x55, 0x88, 0x6d, 0x38, 0x94, 0x8d, 0x1d, 0xbe, 0x4e, 0x35, 0xfc,
-	0xdf, 0xd9, 0xa2, 0x13, 0x30, 0xc5, 0xfa, 0x27, 0xd7, 0x73, 0xc9, 0xa5, 0x26, 0x12, 0xdd, 0x39,
-	0xfe, 0x0c, 0x47, 0x2b, 0x98, 0x92, 0xd4, 0x09, 0x98, 0x3e, 0x9d, 0x90, 0x20, 0x4c, 0x6e, 0xa6,
-	0x72, 0x90, 0x9a, 0x4f, 0xdf, 0xf3, 0x18, 0x3d, 0x07, 0x25, 0x29, 0x0e, 0xb8, 0x89, 0x5a, 0xa6,
-	0xb9, 0x11, 0x3c, 0xee, 0x4d, 0x89, 0xfb, 0x65, 0x05, 0xff, 0x81, 0xa1, 0xef, 0x15, 0x64, 0xe4,
-	0x15, 0x84, 0x5d, 0x78, 0xd4, 0x4d, 0xe6, 0xea, 0x11, 0xb9, 0x80, 0x63, 0xa8, 0x26, 0x94, 0xc4,
-	0x0a, 0xc8, 0xb4, 0x2b, 0x69, 0xb8, 0xa6, 0x03, 0xa3, 0xf8, 0x91, 0xd2, 0xd2, 0x23, 0x4f, 0xc1,
-	0x1c, 0x10, 0x36, 0x4e, 0x31, 0xfa, 0x85, 0xe0, 0x78, 0x04, 0xa7, 0xa9, 0xe8, 0x92, 0xd8, 0x9d,
-	0x3a, 0x94, 0x78, 0x72, 0x26, 0x9a, 0x31, 0xcc, 0x0b, 0x40, 0x7f, 0x48, 0x00, 0x17, 0x77, 0x65,
-	0xd8, 0x93, 0xd9, 0x2b, 0x61, 0x62, 0x74, 0x09, 0xbb, 0x3d, 0xee, 0x0d, 0x65, 0xd9, 0xb5, 0xfe,
-	0xfa, 0x5a, 0x06, 0x6b, 0xe8, 0x2d, 0x57, 0xb7, 0x8c, 0xbb, 0xf3, 0xa1, 0x87, 0x8e, 0xb2, 0x5b,
-	0x79, 0x97, 0x6c, 0x6c, 0xbe, 0x84, 0xdd, 0x31, 0x37, 0xdc, 0xbf, 0xbd, 0xf9, 0x1a, 0x76, 0xfb,
-	0xdc, 0x8b, 0xaa, 0xad, 0xe0, 0xc9, 0xfd, 0x2c, 0x2d, 0xd4, 0x8e, 0x35, 0xd4, 0x83, 0x9d, 0xd4,
-	0x4d, 0x6a, 0x7d, 0xe8, 0x38, 0xdf, 0x99, 0xf3, 0x68, 0xdd, 0x5a, 0x2f, 0x88, 0x4d, 0x63, 0x0d,
-	0x7d, 0x84, 0xa3, 0x61, 0x90, 0xfe, 0x90, 0x50, 0xb2, 0x24, 0x67, 0xd4, 0x58, 0x1d, 0x76, 0xc9,
-	0x3a, 0xf5, 0x66, 0x51, 0x39, 0x8f, 0xdc, 0x27, 0xff, 0x05, 0xb9, 0x0f, 0xdb, 0x39, 0x63, 0x14,
-	0x6d, 0xec, 0x34, 0x4b, 0x6f, 0x70, 0x11, 0xd6, 0xd0, 0x3b, 0x80, 0x7b, 0x43, 0xa0, 0x7a, 0x76,
-	0x7b, 0xcd, 0x25, 0x1b, 0xbf, 0xdb, 0xa7, 0xcd, 0x1a, 0xee, 0xce, 0xc7, 0xc2, 0x40, 0x28, 0x3f,
-	0x94, 0x30, 0x44, 0xfd, 0xd9, 0xd2, 0xa0, 0x45, 0xf2, 0xc7, 0x5a, 0xf7, 0xe0, 0xc7, 0xa2, 0xa9,
-	0xdf, 0x2d, 0x9a, 0xfa, 0xaf, 0x45, 0x53, 0xff, 0xfe, 0xbb, 0xa9, 0x5d, 0x57, 0xf8, 0xff, 0xd1,
-	0xab, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x53, 0x89, 0x52, 0xde, 0xb0, 0x06, 0x00, 0x00,
+func (m *BuyProductRequest) Reset()         { *m = BuyProductRequest{} }
+func (m *BuyProductRequest) String() string { return proto.CompactTextString(m) }
+func (*BuyProductRequest) ProtoMessage()    {}
+func (*BuyProductRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{15}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ProductServiceClient is the client API for ProductService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProductServiceClient interface {
-	CreateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error)
-	GetProductById(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Product, error)
-	UpdateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error)
-	DeleteProduct(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Status, error)
-	ListProducts(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*GetListResponse, error)
-	IncreaseProductAmount(ctx context.Context, in *ProductAmountRequest, opts ...grpc.CallOption) (*ProductAmountResponse, error)
-	DecreaseProductAmount(ctx context.Context, in *ProductAmountRequest, opts ...grpc.CallOption) (*ProductAmountResponse, error)
-	CheckAmount(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*CheckAmountResponse, error)
-	BuyProduct(ctx context.Context, in *BuyProductRequest, opts ...grpc.CallOption) (*Product, error)
-	GetPurchasedProductsByUserId(ctx context.Context, in *GetUserID, opts ...grpc.CallOption) (*GetPurchasedProductsResponse, error)
+func (m *BuyProductRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type productServiceClient struct {
-	cc *grpc.ClientConn
+func (m *BuyProductRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyProductRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewProductServiceClient(cc *grpc.ClientConn) ProductServiceClient {
-	return &productServiceClient{cc}
+func (m *BuyProductRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyProductRequest.Merge(m, src)
+}
+func (m *BuyProductRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyProductRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyProductRequest.DiscardUnknown(m)
 }
 
-func (c *productServiceClient) CreateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error) {
-	out := new(Product)
-	err := c.cc.Invoke(ctx, "/product.ProductService/CreateProduct", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BuyProductRequest proto.InternalMessageInfo
+
+func (m *BuyProductRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *productServiceClient) GetProductById(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Product, error) {
-	out := new(Product)
-	err := c.cc.Invoke(ctx, "/product.ProductService/GetProductById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BuyProductRequest) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *productServiceClient) UpdateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error) {
-	out := new(Product)
-	err := c.cc.Invoke(ctx, "/product.ProductService/UpdateProduct", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BuyProductRequest) GetAmount() int32 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *productServiceClient) DeleteProduct(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/product.ProductService/DeleteProduct", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type OrderLine struct {
+	ProductId            int32    `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Quantity             int32    `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *productServiceClient) ListProducts(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*GetListResponse, error) {
-	out := new(GetListResponse)
-	err := c.cc.Invoke(ctx, "/product.ProductService/ListProducts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderLine) Reset()         { *m = OrderLine{} }
+func (m *OrderLine) String() string { return proto.CompactTextString(m) }
+func (*OrderLine) ProtoMessage()    {}
+func (*OrderLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{16}
+}
+func (m *OrderLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *OrderLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderLine.Merge(m, src)
+}
+func (m *OrderLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderLine.DiscardUnknown(m)
 }
 
-func (c *productServiceClient) IncreaseProductAmount(ctx context.Context, in *ProductAmountRequest, opts ...grpc.CallOption) (*ProductAmountResponse, error) {
-	out := new(ProductAmountResponse)
-	err := c.cc.Invoke(ctx, "/product.ProductService/IncreaseProductAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrderLine proto.InternalMessageInfo
+
+func (m *OrderLine) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *productServiceClient) DecreaseProductAmount(ctx context.Context, in *ProductAmountRequest, opts ...grpc.CallOption) (*ProductAmountResponse, error) {
-	out := new(ProductAmountResponse)
-	err := c.cc.Invoke(ctx, "/product.ProductService/DecreaseProductAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderLine) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
 	}
-	return out, nil
+	return 0
 }
 
-func (c *productServiceClient) CheckAmount(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*CheckAmountResponse, error) {
-	out := new(CheckAmountResponse)
-	err := c.cc.Invoke(ctx, "/product.ProductService/CheckAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+type CreateOrderRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	// Lines for the same product are merged.
+	Items                []*OrderLine `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateOrderRequest) Reset()         { *m = CreateOrderRequest{} }
+func (m *CreateOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOrderRequest) ProtoMessage()    {}
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{17}
+}
+func (m *CreateOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CreateOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrderRequest.Merge(m, src)
+}
+func (m *CreateOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrderRequest.DiscardUnknown(m)
 }
 
-func (c *productServiceClient) BuyProduct(ctx context.Context, in *BuyProductRequest, opts ...grpc.CallOption) (*Product, error) {
-	out := new(Product)
-	err := c.cc.Invoke(ctx, "/product.ProductService/BuyProduct", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateOrderRequest proto.InternalMessageInfo
+
+func (m *CreateOrderRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *productServiceClient) GetPurchasedProductsByUserId(ctx context.Context, in *GetUserID, opts ...grpc.CallOption) (*GetPurchasedProductsResponse, error) {
-	out := new(GetPurchasedProductsResponse)
-	err := c.cc.Invoke(ctx, "/product.ProductService/GetPurchasedProductsByUserId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateOrderRequest) GetItems() []*OrderLine {
+	if m != nil {
+		return m.Items
 	}
-	return out, nil
+	return nil
 }
 
-// ProductServiceServer is the server API for ProductService service.
-type ProductServiceServer interface {
-	CreateProduct(context.Context, *Product) (*Product, error)
-	GetProductById(context.Context, *GetProductId) (*Product, error)
-	UpdateProduct(context.Context, *Product) (*Product, error)
-	DeleteProduct(context.Context, *GetProductId) (*Status, error)
-	ListProducts(context.Context, *GetListRequest) (*GetListResponse, error)
-	IncreaseProductAmount(context.Context, *ProductAmountRequest) (*ProductAmountResponse, error)
-	DecreaseProductAmount(context.Context, *ProductAmountRequest) (*ProductAmountResponse, error)
-	CheckAmount(context.Context, *GetProductId) (*CheckAmountResponse, error)
-	BuyProduct(context.Context, *BuyProductRequest) (*Product, error)
-	GetPurchasedProductsByUserId(context.Context, *GetUserID) (*GetPurchasedProductsResponse, error)
+type OrderItem struct {
+	ProductId int32 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Quantity  int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity"`
+	// Product price when the order was placed.
+	UnitPrice            float32  `protobuf:"fixed32,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedProductServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedProductServiceServer struct {
+func (m *OrderItem) Reset()         { *m = OrderItem{} }
+func (m *OrderItem) String() string { return proto.CompactTextString(m) }
+func (*OrderItem) ProtoMessage()    {}
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{18}
+}
+func (m *OrderItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderItem.Merge(m, src)
+}
+func (m *OrderItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderItem.DiscardUnknown(m)
 }
 
-func (*UnimplementedProductServiceServer) CreateProduct(ctx context.Context, req *Product) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+var xxx_messageInfo_OrderItem proto.InternalMessageInfo
+
+func (m *OrderItem) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
+	}
+	return 0
 }
-func (*UnimplementedProductServiceServer) GetProductById(ctx context.Context, req *GetProductId) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProductById not implemented")
+
+func (m *OrderItem) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
 }
-func (*UnimplementedProductServiceServer) UpdateProduct(ctx context.Context, req *Product) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+
+func (m *OrderItem) GetUnitPrice() float32 {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return 0
 }
-func (*UnimplementedProductServiceServer) DeleteProduct(ctx context.Context, req *GetProductId) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+
+type Order struct {
+	Id     int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	// Ordered by product_id.
+	Items []*OrderItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+	// Sum of quantity * unit_price over the items.
+	TotalPrice    float32     `protobuf:"fixed32,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price"`
+	TotalQuantity int32       `protobuf:"varint,5,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity"`
+	CreatedAt     string      `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	Status        OrderStatus `protobuf:"varint,7,opt,name=status,proto3,enum=product.OrderStatus" json:"status"`
+	// When the order entered each status; empty until it does.
+	PaidAt               string   `protobuf:"bytes,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at"`
+	ShippedAt            string   `protobuf:"bytes,9,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at"`
+	DeliveredAt          string   `protobuf:"bytes,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at"`
+	CancelledAt          string   `protobuf:"bytes,11,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at"`
+	RefundedAt           string   `protobuf:"bytes,12,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedProductServiceServer) ListProducts(ctx context.Context, req *GetListRequest) (*GetListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{19}
 }
-func (*UnimplementedProductServiceServer) IncreaseProductAmount(ctx context.Context, req *ProductAmountRequest) (*ProductAmountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncreaseProductAmount not implemented")
+func (m *Order) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedProductServiceServer) DecreaseProductAmount(ctx context.Context, req *ProductAmountRequest) (*ProductAmountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DecreaseProductAmount not implemented")
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Order.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedProductServiceServer) CheckAmount(ctx context.Context, req *GetProductId) (*CheckAmountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckAmount not implemented")
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
 }
-func (*UnimplementedProductServiceServer) BuyProduct(ctx context.Context, req *BuyProductRequest) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BuyProduct not implemented")
+func (m *Order) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedProductServiceServer) GetPurchasedProductsByUserId(ctx context.Context, req *GetUserID) (*GetPurchasedProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPurchasedProductsByUserId not implemented")
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
 }
 
-func RegisterProductServiceServer(s *grpc.Server, srv ProductServiceServer) {
-	s.RegisterService(&_ProductService_serviceDesc, srv)
-}
+var xxx_messageInfo_Order proto.InternalMessageInfo
 
-func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Product)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateProduct(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/CreateProduct",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*Product))
+func (m *Order) GetId() int32 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ProductService_GetProductById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductId)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).GetProductById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/GetProductById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetProductById(ctx, req.(*GetProductId))
+func (m *Order) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Product)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/UpdateProduct",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*Product))
+func (m *Order) GetItems() []*OrderItem {
+	if m != nil {
+		return m.Items
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductId)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/DeleteProduct",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*GetProductId))
+func (m *Order) GetTotalPrice() float32 {
+	if m != nil {
+		return m.TotalPrice
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).ListProducts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/ListProducts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListProducts(ctx, req.(*GetListRequest))
+func (m *Order) GetTotalQuantity() int32 {
+	if m != nil {
+		return m.TotalQuantity
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ProductService_IncreaseProductAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProductAmountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).IncreaseProductAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/IncreaseProductAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).IncreaseProductAmount(ctx, req.(*ProductAmountRequest))
+func (m *Order) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ProductService_DecreaseProductAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProductAmountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).DecreaseProductAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/DecreaseProductAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DecreaseProductAmount(ctx, req.(*ProductAmountRequest))
+func (m *Order) GetStatus() OrderStatus {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return OrderStatus_ORDER_PENDING
 }
 
-func _ProductService_CheckAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductId)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).CheckAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/CheckAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CheckAmount(ctx, req.(*GetProductId))
+func (m *Order) GetPaidAt() string {
+	if m != nil {
+		return m.PaidAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ProductService_BuyProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuyProductRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).BuyProduct(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/BuyProduct",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).BuyProduct(ctx, req.(*BuyProductRequest))
+func (m *Order) GetShippedAt() string {
+	if m != nil {
+		return m.ShippedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ProductService_GetPurchasedProductsByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProductServiceServer).GetPurchasedProductsByUserId(ctx, in)
+func (m *Order) GetDeliveredAt() string {
+	if m != nil {
+		return m.DeliveredAt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/product.ProductService/GetPurchasedProductsByUserId",
+	return ""
+}
+
+func (m *Order) GetCancelledAt() string {
+	if m != nil {
+		return m.CancelledAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetPurchasedProductsByUserId(ctx, req.(*GetUserID))
+	return ""
+}
+
+func (m *Order) GetRefundedAt() string {
+	if m != nil {
+		return m.RefundedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _ProductService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "product.ProductService",
-	HandlerType: (*ProductServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateProduct",
-			Handler:    _ProductService_CreateProduct_Handler,
-		},
-		{
-			MethodName: "GetProductById",
-			Handler:    _ProductService_GetProductById_Handler,
-		},
-		{
-			MethodName: "UpdateProduct",
-			Handler:    _ProductService_UpdateProduct_Handler,
-		},
-		{
-			MethodName: "DeleteProduct",
-			Handler:    _ProductService_DeleteProduct_Handler,
-		},
-		{
-			MethodName: "ListProducts",
-			Handler:    _ProductService_ListProducts_Handler,
-		},
-		{
-			MethodName: "IncreaseProductAmount",
-			Handler:    _ProductService_IncreaseProductAmount_Handler,
-		},
-		{
-			MethodName: "DecreaseProductAmount",
-			Handler:    _ProductService_DecreaseProductAmount_Handler,
-		},
-		{
-			MethodName: "CheckAmount",
-			Handler:    _ProductService_CheckAmount_Handler,
-		},
-		{
-			MethodName: "BuyProduct",
-			Handler:    _ProductService_BuyProduct_Handler,
-		},
-		{
-			MethodName: "GetPurchasedProductsByUserId",
-			Handler:    _ProductService_GetPurchasedProductsByUserId_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "product-service/product.proto",
+type TransitionOrderRequest struct {
+	OrderId              int32       `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	Status               OrderStatus `protobuf:"varint,2,opt,name=status,proto3,enum=product.OrderStatus" json:"status"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Product) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransitionOrderRequest) Reset()         { *m = TransitionOrderRequest{} }
+func (m *TransitionOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*TransitionOrderRequest) ProtoMessage()    {}
+func (*TransitionOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{20}
+}
+func (m *TransitionOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransitionOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransitionOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Product) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TransitionOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransitionOrderRequest.Merge(m, src)
+}
+func (m *TransitionOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransitionOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransitionOrderRequest.DiscardUnknown(m)
 }
 
-func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Deleted) > 0 {
-		i -= len(m.Deleted)
-		copy(dAtA[i:], m.Deleted)
-		i = encodeVarintProduct(dAtA, i, uint64(len(m.Deleted)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintProduct(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Amount != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Price != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Price))))
-		i--
-		dAtA[i] = 0x25
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProduct(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintProduct(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_TransitionOrderRequest proto.InternalMessageInfo
+
+func (m *TransitionOrderRequest) GetOrderId() int32 {
+	if m != nil {
+		return m.OrderId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetProductId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransitionOrderRequest) GetStatus() OrderStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return OrderStatus_ORDER_PENDING
 }
 
-func (m *GetProductId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Paid, shipped and delivered orders take returns, up to the quantity
+// bought per product.
+type ReturnOrderItemRequest struct {
+	OrderId   int32        `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	ProductId int32        `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Quantity  int32        `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity"`
+	Reason    ReturnReason `protobuf:"varint,4,opt,name=reason,proto3,enum=product.ReturnReason" json:"reason"`
+	// Put the returned quantity back in stock, like IncreaseProductAmount.
+	Restock              bool     `protobuf:"varint,5,opt,name=restock,proto3" json:"restock"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetProductId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ProductId != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
-		i--
-		dAtA[i] = 0x8
+func (m *ReturnOrderItemRequest) Reset()         { *m = ReturnOrderItemRequest{} }
+func (m *ReturnOrderItemRequest) String() string { return proto.CompactTextString(m) }
+func (*ReturnOrderItemRequest) ProtoMessage()    {}
+func (*ReturnOrderItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{21}
+}
+func (m *ReturnOrderItemRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReturnOrderItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReturnOrderItemRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ReturnOrderItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReturnOrderItemRequest.Merge(m, src)
+}
+func (m *ReturnOrderItemRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReturnOrderItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReturnOrderItemRequest.DiscardUnknown(m)
 }
 
-func (m *GetListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReturnOrderItemRequest proto.InternalMessageInfo
+
+func (m *ReturnOrderItemRequest) GetOrderId() int32 {
+	if m != nil {
+		return m.OrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReturnOrderItemRequest) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
+	}
+	return 0
 }
 
-func (m *GetListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Limit != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
+func (m *ReturnOrderItemRequest) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
 	}
-	if m.Page != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ReturnOrderItemRequest) GetReason() ReturnReason {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ReturnReason_RETURN_OTHER
 }
 
-func (m *GetListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReturnOrderItemRequest) GetRestock() bool {
+	if m != nil {
+		return m.Restock
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GetListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OrderReturn struct {
+	Id        int32        `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	OrderId   int32        `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	ProductId int32        `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Quantity  int32        `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity"`
+	Reason    ReturnReason `protobuf:"varint,5,opt,name=reason,proto3,enum=product.ReturnReason" json:"reason"`
+	Restocked bool         `protobuf:"varint,6,opt,name=restocked,proto3" json:"restocked"`
+	// quantity * the unit price paid.
+	RefundAmount         float32  `protobuf:"fixed32,7,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount"`
+	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *OrderReturn) Reset()         { *m = OrderReturn{} }
+func (m *OrderReturn) String() string { return proto.CompactTextString(m) }
+func (*OrderReturn) ProtoMessage()    {}
+func (*OrderReturn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{22}
+}
+func (m *OrderReturn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderReturn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderReturn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Products) > 0 {
-		for iNdEx := len(m.Products) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Products[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProduct(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Count != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *OrderReturn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderReturn.Merge(m, src)
+}
+func (m *OrderReturn) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderReturn) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderReturn.DiscardUnknown(m)
 }
 
-func (m *Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrderReturn proto.InternalMessageInfo
+
+func (m *OrderReturn) GetId() int32 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrderReturn) GetOrderId() int32 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
 }
 
-func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *OrderReturn) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProductAmountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrderReturn) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProductAmountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrderReturn) GetReason() ReturnReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ReturnReason_RETURN_OTHER
 }
 
-func (m *ProductAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.AmountBy != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.AmountBy))
-		i--
-		dAtA[i] = 0x10
+func (m *OrderReturn) GetRestocked() bool {
+	if m != nil {
+		return m.Restocked
 	}
-	if m.ProductId != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *OrderReturn) GetRefundAmount() float32 {
+	if m != nil {
+		return m.RefundAmount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProductAmountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrderReturn) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ProductAmountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetOrderId struct {
+	OrderId              int32    `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ProductAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Product != nil {
-		{
-			size, err := m.Product.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProduct(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.IsEnough {
-		i--
-		if m.IsEnough {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *GetOrderId) Reset()         { *m = GetOrderId{} }
+func (m *GetOrderId) String() string { return proto.CompactTextString(m) }
+func (*GetOrderId) ProtoMessage()    {}
+func (*GetOrderId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{23}
+}
+func (m *GetOrderId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOrderId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOrderId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetOrderId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderId.Merge(m, src)
+}
+func (m *GetOrderId) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOrderId) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderId.DiscardUnknown(m)
 }
 
-func (m *CheckAmountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetOrderId proto.InternalMessageInfo
+
+func (m *GetOrderId) GetOrderId() int32 {
+	if m != nil {
+		return m.OrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CheckAmountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListOrdersByUserRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Page                 int32    `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CheckAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Amount != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ProductId != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
-		i--
-		dAtA[i] = 0x8
+func (m *ListOrdersByUserRequest) Reset()         { *m = ListOrdersByUserRequest{} }
+func (m *ListOrdersByUserRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersByUserRequest) ProtoMessage()    {}
+func (*ListOrdersByUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{24}
+}
+func (m *ListOrdersByUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListOrdersByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListOrdersByUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListOrdersByUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersByUserRequest.Merge(m, src)
+}
+func (m *ListOrdersByUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListOrdersByUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersByUserRequest.DiscardUnknown(m)
 }
 
-func (m *BuyProductRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListOrdersByUserRequest proto.InternalMessageInfo
+
+func (m *ListOrdersByUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BuyProductRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListOrdersByUserRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
 }
 
-func (m *BuyProductRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Amount != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ProductId != 0 {
-		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintProduct(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ListOrdersByUserRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetUserID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ListOrdersResponse struct {
+	// Newest first.
+	Orders               []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	TotalCount           int64    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetUserID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListOrdersResponse) Reset()         { *m = ListOrdersResponse{} }
+func (m *ListOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersResponse) ProtoMessage()    {}
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{25}
 }
-
-func (m *GetUserID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ListOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintProduct(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ListOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersResponse.Merge(m, src)
+}
+func (m *ListOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersResponse proto.InternalMessageInfo
+
+func (m *ListOrdersResponse) GetOrders() []*Order {
+	if m != nil {
+		return m.Orders
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetPurchasedProductsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListOrdersResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetPurchasedProductsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StockMovement is an immutable ledger entry for one change of a product amount
+type StockMovement struct {
+	Id        int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	ProductId int32               `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Delta     int32               `protobuf:"varint,3,opt,name=delta,proto3" json:"delta"`
+	Reason    StockMovementReason `protobuf:"varint,4,opt,name=reason,proto3,enum=product.StockMovementReason" json:"reason"`
+	// Caller named in the x-actor request header, or "system".
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor"`
+	// What caused the change, e.g. "order/12" or "reservation/3"; empty for manual changes.
+	Reference string `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference"`
+	// Amount of the product after the change.
+	Balance              int32    `protobuf:"varint,7,opt,name=balance,proto3" json:"balance"`
+	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPurchasedProductsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Products) > 0 {
-		for iNdEx := len(m.Products) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Products[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProduct(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *StockMovement) Reset()         { *m = StockMovement{} }
+func (m *StockMovement) String() string { return proto.CompactTextString(m) }
+func (*StockMovement) ProtoMessage()    {}
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{26}
+}
+func (m *StockMovement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StockMovement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StockMovement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StockMovement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StockMovement.Merge(m, src)
+}
+func (m *StockMovement) XXX_Size() int {
+	return m.Size()
+}
+func (m *StockMovement) XXX_DiscardUnknown() {
+	xxx_messageInfo_StockMovement.DiscardUnknown(m)
 }
 
-func encodeVarintProduct(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProduct(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_StockMovement proto.InternalMessageInfo
+
+func (m *StockMovement) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Product) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovProduct(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovProduct(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProduct(uint64(l))
-	}
-	if m.Price != 0 {
-		n += 5
-	}
-	if m.Amount != 0 {
-		n += 1 + sovProduct(uint64(m.Amount))
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovProduct(uint64(l))
-	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovProduct(uint64(l))
-	}
-	l = len(m.Deleted)
-	if l > 0 {
-		n += 1 + l + sovProduct(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *StockMovement) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
 	}
-	return n
+	return 0
 }
 
-func (m *GetProductId) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProductId != 0 {
-		n += 1 + sovProduct(uint64(m.ProductId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StockMovement) GetDelta() int32 {
+	if m != nil {
+		return m.Delta
 	}
-	return n
+	return 0
 }
 
-func (m *GetListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Page != 0 {
-		n += 1 + sovProduct(uint64(m.Page))
+func (m *StockMovement) GetReason() StockMovementReason {
+	if m != nil {
+		return m.Reason
 	}
-	if m.Limit != 0 {
-		n += 1 + sovProduct(uint64(m.Limit))
+	return StockMovementReason_MOVEMENT_OPENING
+}
+
+func (m *StockMovement) GetActor() string {
+	if m != nil {
+		return m.Actor
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *StockMovement) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return n
+	return ""
 }
 
-func (m *GetListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StockMovement) GetBalance() int32 {
+	if m != nil {
+		return m.Balance
 	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovProduct(uint64(m.Count))
+	return 0
+}
+
+func (m *StockMovement) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.Products) > 0 {
-		for _, e := range m.Products {
-			l = e.Size()
-			n += 1 + l + sovProduct(uint64(l))
+	return ""
+}
+
+type ListStockMovementsRequest struct {
+	ProductId int32 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	// RFC 3339 bounds on created_at: from is inclusive, to exclusive; empty leaves a side open.
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
+	Page                 int32    `protobuf:"varint,4,opt,name=page,proto3" json:"page"`
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListStockMovementsRequest) Reset()         { *m = ListStockMovementsRequest{} }
+func (m *ListStockMovementsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListStockMovementsRequest) ProtoMessage()    {}
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{27}
+}
+func (m *ListStockMovementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListStockMovementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListStockMovementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *ListStockMovementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStockMovementsRequest.Merge(m, src)
+}
+func (m *ListStockMovementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListStockMovementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStockMovementsRequest.DiscardUnknown(m)
 }
 
-func (m *Status) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+var xxx_messageInfo_ListStockMovementsRequest proto.InternalMessageInfo
+
+func (m *ListStockMovementsRequest) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *ListStockMovementsRequest) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return n
+	return ""
 }
 
-func (m *ProductAmountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListStockMovementsRequest) GetTo() string {
+	if m != nil {
+		return m.To
 	}
-	var l int
-	_ = l
-	if m.ProductId != 0 {
-		n += 1 + sovProduct(uint64(m.ProductId))
+	return ""
+}
+
+func (m *ListStockMovementsRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
 	}
-	if m.AmountBy != 0 {
-		n += 1 + sovProduct(uint64(m.AmountBy))
+	return 0
+}
+
+func (m *ListStockMovementsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+type ListStockMovementsResponse struct {
+	// Oldest first.
+	Movements            []*StockMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements"`
+	TotalCount           int64            `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListStockMovementsResponse) Reset()         { *m = ListStockMovementsResponse{} }
+func (m *ListStockMovementsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListStockMovementsResponse) ProtoMessage()    {}
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{28}
+}
+func (m *ListStockMovementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListStockMovementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListStockMovementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ListStockMovementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStockMovementsResponse.Merge(m, src)
+}
+func (m *ListStockMovementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListStockMovementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStockMovementsResponse.DiscardUnknown(m)
 }
 
-func (m *ProductAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ListStockMovementsResponse proto.InternalMessageInfo
+
+func (m *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if m != nil {
+		return m.Movements
 	}
-	var l int
-	_ = l
-	if m.IsEnough {
-		n += 2
+	return nil
+}
+
+func (m *ListStockMovementsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
 	}
-	if m.Product != nil {
-		l = m.Product.Size()
-		n += 1 + l + sovProduct(uint64(l))
+	return 0
+}
+
+type ReconcileStockRequest struct {
+	// 0 checks every product.
+	ProductId            int32    `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconcileStockRequest) Reset()         { *m = ReconcileStockRequest{} }
+func (m *ReconcileStockRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileStockRequest) ProtoMessage()    {}
+func (*ReconcileStockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{29}
+}
+func (m *ReconcileStockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileStockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileStockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *ReconcileStockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileStockRequest.Merge(m, src)
+}
+func (m *ReconcileStockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileStockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileStockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileStockRequest proto.InternalMessageInfo
+
+func (m *ReconcileStockRequest) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
 	}
-	return n
+	return 0
 }
 
-func (m *CheckAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type StockDiscrepancy struct {
+	ProductId int32 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Amount    int32 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount"`
+	// Sum of the ledger deltas of the product.
+	LedgerAmount         int32    `protobuf:"varint,3,opt,name=ledger_amount,json=ledgerAmount,proto3" json:"ledger_amount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StockDiscrepancy) Reset()         { *m = StockDiscrepancy{} }
+func (m *StockDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*StockDiscrepancy) ProtoMessage()    {}
+func (*StockDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{30}
+}
+func (m *StockDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StockDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StockDiscrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.ProductId != 0 {
-		n += 1 + sovProduct(uint64(m.ProductId))
+}
+func (m *StockDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StockDiscrepancy.Merge(m, src)
+}
+func (m *StockDiscrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *StockDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_StockDiscrepancy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StockDiscrepancy proto.InternalMessageInfo
+
+func (m *StockDiscrepancy) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
 	}
-	if m.Amount != 0 {
-		n += 1 + sovProduct(uint64(m.Amount))
+	return 0
+}
+
+func (m *StockDiscrepancy) GetAmount() int32 {
+	if m != nil {
+		return m.Amount
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *StockDiscrepancy) GetLedgerAmount() int32 {
+	if m != nil {
+		return m.LedgerAmount
+	}
+	return 0
+}
+
+type ReconcileStockResponse struct {
+	Checked              int64               `protobuf:"varint,1,opt,name=checked,proto3" json:"checked"`
+	Discrepancies        []*StockDiscrepancy `protobuf:"bytes,2,rep,name=discrepancies,proto3" json:"discrepancies"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReconcileStockResponse) Reset()         { *m = ReconcileStockResponse{} }
+func (m *ReconcileStockResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconcileStockResponse) ProtoMessage()    {}
+func (*ReconcileStockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{31}
+}
+func (m *ReconcileStockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileStockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileStockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconcileStockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileStockResponse.Merge(m, src)
+}
+func (m *ReconcileStockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileStockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileStockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileStockResponse proto.InternalMessageInfo
+
+func (m *ReconcileStockResponse) GetChecked() int64 {
+	if m != nil {
+		return m.Checked
+	}
+	return 0
+}
+
+func (m *ReconcileStockResponse) GetDiscrepancies() []*StockDiscrepancy {
+	if m != nil {
+		return m.Discrepancies
+	}
+	return nil
+}
+
+type GetProductsByIdsRequest struct {
+	ProductIds           []int32  `protobuf:"varint,1,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids"`
+	IncludeDeleted       bool     `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProductsByIdsRequest) Reset()         { *m = GetProductsByIdsRequest{} }
+func (m *GetProductsByIdsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProductsByIdsRequest) ProtoMessage()    {}
+func (*GetProductsByIdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{32}
+}
+func (m *GetProductsByIdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetProductsByIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetProductsByIdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetProductsByIdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProductsByIdsRequest.Merge(m, src)
+}
+func (m *GetProductsByIdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetProductsByIdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProductsByIdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProductsByIdsRequest proto.InternalMessageInfo
+
+func (m *GetProductsByIdsRequest) GetProductIds() []int32 {
+	if m != nil {
+		return m.ProductIds
+	}
+	return nil
+}
+
+func (m *GetProductsByIdsRequest) GetIncludeDeleted() bool {
+	if m != nil {
+		return m.IncludeDeleted
+	}
+	return false
+}
+
+type GetProductsByIdsResponse struct {
+	// Found products in request order; a repeated id repeats its product.
+	Products []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products"`
+	// Requested ids without a product, in request order and without repeats.
+	MissingIds           []int32  `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProductsByIdsResponse) Reset()         { *m = GetProductsByIdsResponse{} }
+func (m *GetProductsByIdsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProductsByIdsResponse) ProtoMessage()    {}
+func (*GetProductsByIdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{33}
+}
+func (m *GetProductsByIdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetProductsByIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetProductsByIdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetProductsByIdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProductsByIdsResponse.Merge(m, src)
+}
+func (m *GetProductsByIdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetProductsByIdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProductsByIdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProductsByIdsResponse proto.InternalMessageInfo
+
+func (m *GetProductsByIdsResponse) GetProducts() []*Product {
+	if m != nil {
+		return m.Products
+	}
+	return nil
+}
+
+func (m *GetProductsByIdsResponse) GetMissingIds() []int32 {
+	if m != nil {
+		return m.MissingIds
+	}
+	return nil
+}
+
+type GetUserID struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUserID) Reset()         { *m = GetUserID{} }
+func (m *GetUserID) String() string { return proto.CompactTextString(m) }
+func (*GetUserID) ProtoMessage()    {}
+func (*GetUserID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{34}
+}
+func (m *GetUserID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetUserID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserID.Merge(m, src)
+}
+func (m *GetUserID) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserID) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserID proto.InternalMessageInfo
+
+func (m *GetUserID) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+// Purchase is one order line as seen by customer support
+type Purchase struct {
+	OrderId          int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	ProductId        int32 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Quantity         int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity"`
+	ReturnedQuantity int32 `protobuf:"varint,4,opt,name=returned_quantity,json=returnedQuantity,proto3" json:"returned_quantity"`
+	// quantity - returned_quantity
+	NetQuantity          int32    `protobuf:"varint,5,opt,name=net_quantity,json=netQuantity,proto3" json:"net_quantity"`
+	UnitPrice            float32  `protobuf:"fixed32,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price"`
+	CreatedAt            string   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Purchase) Reset()         { *m = Purchase{} }
+func (m *Purchase) String() string { return proto.CompactTextString(m) }
+func (*Purchase) ProtoMessage()    {}
+func (*Purchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{35}
+}
+func (m *Purchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Purchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Purchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Purchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Purchase.Merge(m, src)
+}
+func (m *Purchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *Purchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_Purchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Purchase proto.InternalMessageInfo
+
+func (m *Purchase) GetOrderId() int32 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *Purchase) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
+	}
+	return 0
+}
+
+func (m *Purchase) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Purchase) GetReturnedQuantity() int32 {
+	if m != nil {
+		return m.ReturnedQuantity
+	}
+	return 0
+}
+
+func (m *Purchase) GetNetQuantity() int32 {
+	if m != nil {
+		return m.NetQuantity
+	}
+	return 0
+}
+
+func (m *Purchase) GetUnitPrice() float32 {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return 0
+}
+
+func (m *Purchase) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+type GetPurchasedProductsResponse struct {
+	Products []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products"`
+	// One per order line, in the order of products.
+	Purchases            []*Purchase `protobuf:"bytes,2,rep,name=purchases,proto3" json:"purchases"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetPurchasedProductsResponse) Reset()         { *m = GetPurchasedProductsResponse{} }
+func (m *GetPurchasedProductsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPurchasedProductsResponse) ProtoMessage()    {}
+func (*GetPurchasedProductsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{36}
+}
+func (m *GetPurchasedProductsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPurchasedProductsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPurchasedProductsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPurchasedProductsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPurchasedProductsResponse.Merge(m, src)
+}
+func (m *GetPurchasedProductsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPurchasedProductsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPurchasedProductsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPurchasedProductsResponse proto.InternalMessageInfo
+
+func (m *GetPurchasedProductsResponse) GetProducts() []*Product {
+	if m != nil {
+		return m.Products
+	}
+	return nil
+}
+
+func (m *GetPurchasedProductsResponse) GetPurchases() []*Purchase {
+	if m != nil {
+		return m.Purchases
+	}
+	return nil
+}
+
+type PurgeDeletedProductsRequest struct {
+	OlderThan            string   `protobuf:"bytes,1,opt,name=older_than,json=olderThan,proto3" json:"older_than"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeDeletedProductsRequest) Reset()         { *m = PurgeDeletedProductsRequest{} }
+func (m *PurgeDeletedProductsRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeDeletedProductsRequest) ProtoMessage()    {}
+func (*PurgeDeletedProductsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{37}
+}
+func (m *PurgeDeletedProductsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeDeletedProductsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeDeletedProductsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeDeletedProductsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeDeletedProductsRequest.Merge(m, src)
+}
+func (m *PurgeDeletedProductsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeDeletedProductsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeDeletedProductsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeDeletedProductsRequest proto.InternalMessageInfo
+
+func (m *PurgeDeletedProductsRequest) GetOlderThan() string {
+	if m != nil {
+		return m.OlderThan
+	}
+	return ""
+}
+
+type Category struct {
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// Zero for a root category.
+	ParentId int32  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	// Unique, URL-safe identifier, e.g. "home-appliances".
+	Slug string `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug"`
+	// Sort order among siblings, lowest first.
+	Position             int32    `protobuf:"varint,5,opt,name=position,proto3" json:"position"`
+	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Category) Reset()         { *m = Category{} }
+func (m *Category) String() string { return proto.CompactTextString(m) }
+func (*Category) ProtoMessage()    {}
+func (*Category) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{38}
+}
+func (m *Category) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Category) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Category.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Category) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Category.Merge(m, src)
+}
+func (m *Category) XXX_Size() int {
+	return m.Size()
+}
+func (m *Category) XXX_DiscardUnknown() {
+	xxx_messageInfo_Category.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Category proto.InternalMessageInfo
+
+func (m *Category) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Category) GetParentId() int32 {
+	if m != nil {
+		return m.ParentId
+	}
+	return 0
+}
+
+func (m *Category) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Category) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *Category) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *Category) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Category) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type GetCategoryId struct {
+	CategoryId           int32    `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCategoryId) Reset()         { *m = GetCategoryId{} }
+func (m *GetCategoryId) String() string { return proto.CompactTextString(m) }
+func (*GetCategoryId) ProtoMessage()    {}
+func (*GetCategoryId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{39}
+}
+func (m *GetCategoryId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCategoryId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCategoryId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCategoryId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCategoryId.Merge(m, src)
+}
+func (m *GetCategoryId) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCategoryId) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCategoryId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCategoryId proto.InternalMessageInfo
+
+func (m *GetCategoryId) GetCategoryId() int32 {
+	if m != nil {
+		return m.CategoryId
+	}
+	return 0
+}
+
+type ListCategoriesRequest struct {
+	// Children of this category; 0 lists the roots.
+	ParentId int32 `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id"`
+	// Include every descendant, not only direct children.
+	Recursive            bool     `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCategoriesRequest) Reset()         { *m = ListCategoriesRequest{} }
+func (m *ListCategoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCategoriesRequest) ProtoMessage()    {}
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{40}
+}
+func (m *ListCategoriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCategoriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCategoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCategoriesRequest.Merge(m, src)
+}
+func (m *ListCategoriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCategoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCategoriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCategoriesRequest proto.InternalMessageInfo
+
+func (m *ListCategoriesRequest) GetParentId() int32 {
+	if m != nil {
+		return m.ParentId
+	}
+	return 0
+}
+
+func (m *ListCategoriesRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+type ListCategoriesResponse struct {
+	// Ordered by parent_id, position and id.
+	Categories           []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListCategoriesResponse) Reset()         { *m = ListCategoriesResponse{} }
+func (m *ListCategoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCategoriesResponse) ProtoMessage()    {}
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{41}
+}
+func (m *ListCategoriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCategoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCategoriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCategoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCategoriesResponse.Merge(m, src)
+}
+func (m *ListCategoriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCategoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCategoriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCategoriesResponse proto.InternalMessageInfo
+
+func (m *ListCategoriesResponse) GetCategories() []*Category {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+type ProductCategoriesRequest struct {
+	ProductId int32 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	// Replaces the current assignment; empty removes the product from all categories.
+	CategoryIds          []int32  `protobuf:"varint,2,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProductCategoriesRequest) Reset()         { *m = ProductCategoriesRequest{} }
+func (m *ProductCategoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ProductCategoriesRequest) ProtoMessage()    {}
+func (*ProductCategoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{42}
+}
+func (m *ProductCategoriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProductCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProductCategoriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProductCategoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductCategoriesRequest.Merge(m, src)
+}
+func (m *ProductCategoriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProductCategoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductCategoriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProductCategoriesRequest proto.InternalMessageInfo
+
+func (m *ProductCategoriesRequest) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
+	}
+	return 0
+}
+
+func (m *ProductCategoriesRequest) GetCategoryIds() []int32 {
+	if m != nil {
+		return m.CategoryIds
+	}
+	return nil
+}
+
+type SearchProductsRequest struct {
+	// Free text matched against name and description; quoted phrases,
+	// OR and -term are understood.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query"`
+	// Same paging as GetListRequest: page/limit, or page_token from a
+	// previous response.
+	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token"`
+	// Only products in this category or any of its descendants; 0 searches all.
+	CategoryId int32 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id"`
+	// Same as in GetListRequest, over every product matching the query.
+	IncludeFacets        bool      `protobuf:"varint,6,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets"`
+	PriceBounds          []float32 `protobuf:"fixed32,7,rep,packed,name=price_bounds,json=priceBounds,proto3" json:"price_bounds"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SearchProductsRequest) Reset()         { *m = SearchProductsRequest{} }
+func (m *SearchProductsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchProductsRequest) ProtoMessage()    {}
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{43}
+}
+func (m *SearchProductsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchProductsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchProductsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchProductsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchProductsRequest.Merge(m, src)
+}
+func (m *SearchProductsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchProductsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchProductsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchProductsRequest proto.InternalMessageInfo
+
+func (m *SearchProductsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchProductsRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *SearchProductsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchProductsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *SearchProductsRequest) GetCategoryId() int32 {
+	if m != nil {
+		return m.CategoryId
+	}
+	return 0
+}
+
+func (m *SearchProductsRequest) GetIncludeFacets() bool {
+	if m != nil {
+		return m.IncludeFacets
+	}
+	return false
+}
+
+func (m *SearchProductsRequest) GetPriceBounds() []float32 {
+	if m != nil {
+		return m.PriceBounds
+	}
+	return nil
+}
+
+type SearchHit struct {
+	Product *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product"`
+	// Relevance, higher first; only comparable within one response.
+	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score"`
+	// HTML-escaped name and description with matched words wrapped in <em></em>.
+	NameHighlight        string   `protobuf:"bytes,3,opt,name=name_highlight,json=nameHighlight,proto3" json:"name_highlight"`
+	DescriptionHighlight string   `protobuf:"bytes,4,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchHit) Reset()         { *m = SearchHit{} }
+func (m *SearchHit) String() string { return proto.CompactTextString(m) }
+func (*SearchHit) ProtoMessage()    {}
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{44}
+}
+func (m *SearchHit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchHit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchHit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchHit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchHit.Merge(m, src)
+}
+func (m *SearchHit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchHit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchHit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchHit proto.InternalMessageInfo
+
+func (m *SearchHit) GetProduct() *Product {
+	if m != nil {
+		return m.Product
+	}
+	return nil
+}
+
+func (m *SearchHit) GetScore() float32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SearchHit) GetNameHighlight() string {
+	if m != nil {
+		return m.NameHighlight
+	}
+	return ""
+}
+
+func (m *SearchHit) GetDescriptionHighlight() string {
+	if m != nil {
+		return m.DescriptionHighlight
+	}
+	return ""
+}
+
+type SearchProductsResponse struct {
+	// Same counters as GetListResponse, ordered by score and then id.
+	Count                int64        `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Hits                 []*SearchHit `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits"`
+	TotalCount           int64        `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count"`
+	TotalPages           int32        `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages"`
+	HasNext              bool         `protobuf:"varint,5,opt,name=has_next,json=hasNext,proto3" json:"has_next"`
+	NextPageToken        string       `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token"`
+	Facets               *Facets      `protobuf:"bytes,7,opt,name=facets,proto3" json:"facets"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SearchProductsResponse) Reset()         { *m = SearchProductsResponse{} }
+func (m *SearchProductsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchProductsResponse) ProtoMessage()    {}
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{45}
+}
+func (m *SearchProductsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchProductsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchProductsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchProductsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchProductsResponse.Merge(m, src)
+}
+func (m *SearchProductsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchProductsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchProductsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchProductsResponse proto.InternalMessageInfo
+
+func (m *SearchProductsResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SearchProductsResponse) GetHits() []*SearchHit {
+	if m != nil {
+		return m.Hits
+	}
+	return nil
+}
+
+func (m *SearchProductsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *SearchProductsResponse) GetTotalPages() int32 {
+	if m != nil {
+		return m.TotalPages
+	}
+	return 0
+}
+
+func (m *SearchProductsResponse) GetHasNext() bool {
+	if m != nil {
+		return m.HasNext
+	}
+	return false
+}
+
+func (m *SearchProductsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *SearchProductsResponse) GetFacets() *Facets {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
+type SuggestProductsRequest struct {
+	// Case-insensitive start of the product name.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	// Only products with a positive amount.
+	InStock              bool     `protobuf:"varint,3,opt,name=in_stock,json=inStock,proto3" json:"in_stock"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuggestProductsRequest) Reset()         { *m = SuggestProductsRequest{} }
+func (m *SuggestProductsRequest) String() string { return proto.CompactTextString(m) }
+func (*SuggestProductsRequest) ProtoMessage()    {}
+func (*SuggestProductsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{46}
+}
+func (m *SuggestProductsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuggestProductsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuggestProductsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuggestProductsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestProductsRequest.Merge(m, src)
+}
+func (m *SuggestProductsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuggestProductsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestProductsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestProductsRequest proto.InternalMessageInfo
+
+func (m *SuggestProductsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *SuggestProductsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SuggestProductsRequest) GetInStock() bool {
+	if m != nil {
+		return m.InStock
+	}
+	return false
+}
+
+type Suggestion struct {
+	ProductId            int32    `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Suggestion) Reset()         { *m = Suggestion{} }
+func (m *Suggestion) String() string { return proto.CompactTextString(m) }
+func (*Suggestion) ProtoMessage()    {}
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{47}
+}
+func (m *Suggestion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Suggestion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Suggestion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Suggestion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Suggestion.Merge(m, src)
+}
+func (m *Suggestion) XXX_Size() int {
+	return m.Size()
+}
+func (m *Suggestion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Suggestion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Suggestion proto.InternalMessageInfo
+
+func (m *Suggestion) GetProductId() int32 {
+	if m != nil {
+		return m.ProductId
+	}
+	return 0
+}
+
+func (m *Suggestion) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type SuggestProductsResponse struct {
+	// Ordered by lowercase name and then id.
+	Suggestions          []*Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SuggestProductsResponse) Reset()         { *m = SuggestProductsResponse{} }
+func (m *SuggestProductsResponse) String() string { return proto.CompactTextString(m) }
+func (*SuggestProductsResponse) ProtoMessage()    {}
+func (*SuggestProductsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{48}
+}
+func (m *SuggestProductsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuggestProductsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuggestProductsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuggestProductsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestProductsResponse.Merge(m, src)
+}
+func (m *SuggestProductsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuggestProductsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestProductsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestProductsResponse proto.InternalMessageInfo
+
+func (m *SuggestProductsResponse) GetSuggestions() []*Suggestion {
+	if m != nil {
+		return m.Suggestions
+	}
+	return nil
+}
+
+type PurgeDeletedProductsResponse struct {
+	Purged               int64    `protobuf:"varint,1,opt,name=purged,proto3" json:"purged"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeDeletedProductsResponse) Reset()         { *m = PurgeDeletedProductsResponse{} }
+func (m *PurgeDeletedProductsResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeDeletedProductsResponse) ProtoMessage()    {}
+func (*PurgeDeletedProductsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6245fd25d14268cd, []int{49}
+}
+func (m *PurgeDeletedProductsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeDeletedProductsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeDeletedProductsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeDeletedProductsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeDeletedProductsResponse.Merge(m, src)
+}
+func (m *PurgeDeletedProductsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeDeletedProductsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeDeletedProductsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeDeletedProductsResponse proto.InternalMessageInfo
+
+func (m *PurgeDeletedProductsResponse) GetPurged() int64 {
+	if m != nil {
+		return m.Purged
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("product.SortField", SortField_name, SortField_value)
+	proto.RegisterEnum("product.ReservationStatus", ReservationStatus_name, ReservationStatus_value)
+	proto.RegisterEnum("product.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterEnum("product.ReturnReason", ReturnReason_name, ReturnReason_value)
+	proto.RegisterEnum("product.StockMovementReason", StockMovementReason_name, StockMovementReason_value)
+	proto.RegisterType((*Product)(nil), "product.Product")
+	proto.RegisterType((*UpdateProductRequest)(nil), "product.UpdateProductRequest")
+	proto.RegisterType((*GetProductId)(nil), "product.GetProductId")
+	proto.RegisterType((*GetListRequest)(nil), "product.GetListRequest")
+	proto.RegisterType((*GetListResponse)(nil), "product.GetListResponse")
+	proto.RegisterType((*Facets)(nil), "product.Facets")
+	proto.RegisterType((*CategoryFacet)(nil), "product.CategoryFacet")
+	proto.RegisterType((*PriceBucket)(nil), "product.PriceBucket")
+	proto.RegisterType((*Status)(nil), "product.Status")
+	proto.RegisterType((*ProductAmountRequest)(nil), "product.ProductAmountRequest")
+	proto.RegisterType((*ProductAmountResponse)(nil), "product.ProductAmountResponse")
+	proto.RegisterType((*CheckAmountResponse)(nil), "product.CheckAmountResponse")
+	proto.RegisterType((*ReserveStockRequest)(nil), "product.ReserveStockRequest")
+	proto.RegisterType((*Reservation)(nil), "product.Reservation")
+	proto.RegisterType((*GetReservationId)(nil), "product.GetReservationId")
+	proto.RegisterType((*BuyProductRequest)(nil), "product.BuyProductRequest")
+	proto.RegisterType((*OrderLine)(nil), "product.OrderLine")
+	proto.RegisterType((*CreateOrderRequest)(nil), "product.CreateOrderRequest")
+	proto.RegisterType((*OrderItem)(nil), "product.OrderItem")
+	proto.RegisterType((*Order)(nil), "product.Order")
+	proto.RegisterType((*TransitionOrderRequest)(nil), "product.TransitionOrderRequest")
+	proto.RegisterType((*ReturnOrderItemRequest)(nil), "product.ReturnOrderItemRequest")
+	proto.RegisterType((*OrderReturn)(nil), "product.OrderReturn")
+	proto.RegisterType((*GetOrderId)(nil), "product.GetOrderId")
+	proto.RegisterType((*ListOrdersByUserRequest)(nil), "product.ListOrdersByUserRequest")
+	proto.RegisterType((*ListOrdersResponse)(nil), "product.ListOrdersResponse")
+	proto.RegisterType((*StockMovement)(nil), "product.StockMovement")
+	proto.RegisterType((*ListStockMovementsRequest)(nil), "product.ListStockMovementsRequest")
+	proto.RegisterType((*ListStockMovementsResponse)(nil), "product.ListStockMovementsResponse")
+	proto.RegisterType((*ReconcileStockRequest)(nil), "product.ReconcileStockRequest")
+	proto.RegisterType((*StockDiscrepancy)(nil), "product.StockDiscrepancy")
+	proto.RegisterType((*ReconcileStockResponse)(nil), "product.ReconcileStockResponse")
+	proto.RegisterType((*GetProductsByIdsRequest)(nil), "product.GetProductsByIdsRequest")
+	proto.RegisterType((*GetProductsByIdsResponse)(nil), "product.GetProductsByIdsResponse")
+	proto.RegisterType((*GetUserID)(nil), "product.GetUserID")
+	proto.RegisterType((*Purchase)(nil), "product.Purchase")
+	proto.RegisterType((*GetPurchasedProductsResponse)(nil), "product.GetPurchasedProductsResponse")
+	proto.RegisterType((*PurgeDeletedProductsRequest)(nil), "product.PurgeDeletedProductsRequest")
+	proto.RegisterType((*Category)(nil), "product.Category")
+	proto.RegisterType((*GetCategoryId)(nil), "product.GetCategoryId")
+	proto.RegisterType((*ListCategoriesRequest)(nil), "product.ListCategoriesRequest")
+	proto.RegisterType((*ListCategoriesResponse)(nil), "product.ListCategoriesResponse")
+	proto.RegisterType((*ProductCategoriesRequest)(nil), "product.ProductCategoriesRequest")
+	proto.RegisterType((*SearchProductsRequest)(nil), "product.SearchProductsRequest")
+	proto.RegisterType((*SearchHit)(nil), "product.SearchHit")
+	proto.RegisterType((*SearchProductsResponse)(nil), "product.SearchProductsResponse")
+	proto.RegisterType((*SuggestProductsRequest)(nil), "product.SuggestProductsRequest")
+	proto.RegisterType((*Suggestion)(nil), "product.Suggestion")
+	proto.RegisterType((*SuggestProductsResponse)(nil), "product.SuggestProductsResponse")
+	proto.RegisterType((*PurgeDeletedProductsResponse)(nil), "product.PurgeDeletedProductsResponse")
+}
+
+func init() { proto.RegisterFile("product-service/product.proto", fileDescriptor_6245fd25d14268cd) }
+
+var fileDescriptor_6245fd25d14268cd = []byte{
+	// 3134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4b, 0x73, 0xdb, 0xd6,
+	0xd5, 0x02, 0xdf, 0x3c, 0x7c, 0x08, 0xba, 0xa2, 0x24, 0x9a, 0x72, 0x6c, 0x19, 0x79, 0xf9, 0x73,
+	0x12, 0xe7, 0x8b, 0xf3, 0x98, 0xa4, 0xce, 0x4c, 0x86, 0x22, 0x61, 0x99, 0xa9, 0x45, 0x31, 0x20,
+	0xad, 0xa4, 0x6d, 0x32, 0x28, 0x0c, 0x5e, 0x91, 0x18, 0x93, 0x00, 0x0d, 0x80, 0x1e, 0x69, 0x91,
+	0xe9, 0x74, 0xda, 0x45, 0x57, 0x5d, 0x76, 0xfa, 0x17, 0xfa, 0x0b, 0xda, 0xfe, 0x83, 0x4e, 0x37,
+	0xed, 0x4f, 0x68, 0xd3, 0x7d, 0xb3, 0xec, 0xb6, 0x73, 0x1f, 0x00, 0x2e, 0x41, 0x50, 0x54, 0xe2,
+	0xc9, 0xa2, 0x3b, 0x9e, 0x07, 0xce, 0x3d, 0xaf, 0x7b, 0xee, 0xb9, 0xe7, 0x12, 0x5e, 0x9a, 0xb9,
+	0xce, 0x70, 0x6e, 0xfa, 0x6f, 0x79, 0xd8, 0x7d, 0x6e, 0x99, 0xf8, 0x6d, 0x0e, 0xdf, 0x9d, 0xb9,
+	0x8e, 0xef, 0xa0, 0x3c, 0x07, 0x1b, 0x07, 0x23, 0xc7, 0x19, 0x4d, 0x28, 0xd9, 0x77, 0x9e, 0xcc,
+	0xcf, 0xde, 0x3e, 0xb3, 0xf0, 0x64, 0xa8, 0x4f, 0x0d, 0xef, 0x29, 0x63, 0x55, 0xbe, 0x95, 0x20,
+	0xdf, 0x63, 0xdc, 0xa8, 0x0a, 0x29, 0x6b, 0x58, 0x97, 0x0e, 0xa4, 0xdb, 0x59, 0x2d, 0x65, 0x0d,
+	0x11, 0x82, 0x8c, 0x6d, 0x4c, 0x71, 0x3d, 0x75, 0x20, 0xdd, 0x2e, 0x6a, 0xf4, 0x37, 0x3a, 0x80,
+	0xd2, 0x10, 0x7b, 0xa6, 0x6b, 0xcd, 0x7c, 0xcb, 0xb1, 0xeb, 0x69, 0x4a, 0x12, 0x51, 0xa8, 0x06,
+	0xd9, 0x99, 0x6b, 0x99, 0xb8, 0x9e, 0x39, 0x90, 0x6e, 0xa7, 0x34, 0x06, 0xa0, 0x5d, 0xc8, 0x19,
+	0x53, 0x67, 0x6e, 0xfb, 0xf5, 0x2c, 0x95, 0xcf, 0x21, 0xf4, 0x12, 0x80, 0xe9, 0x62, 0xc3, 0xc7,
+	0x43, 0xdd, 0xf0, 0xeb, 0x39, 0x2a, 0xae, 0xc8, 0x31, 0x4d, 0x4a, 0x9e, 0xcf, 0x86, 0x01, 0x39,
+	0xcf, 0xc8, 0x1c, 0xd3, 0xf4, 0x51, 0x1d, 0xf2, 0x43, 0x3c, 0xc1, 0x3e, 0x1e, 0xd6, 0x0b, 0x94,
+	0x16, 0x80, 0x84, 0xf2, 0x1c, 0xbb, 0x1e, 0xd1, 0xb1, 0x78, 0x20, 0xdd, 0x4e, 0x6b, 0x01, 0xa8,
+	0xfc, 0x02, 0x6a, 0x8f, 0xa9, 0x00, 0x6e, 0xb6, 0x86, 0x9f, 0xcd, 0xb1, 0xe7, 0xa3, 0x3b, 0x10,
+	0xb8, 0x8d, 0xba, 0xa0, 0x74, 0x4f, 0xbe, 0x1b, 0x78, 0x35, 0xe0, 0x0c, 0x18, 0xd0, 0x7d, 0x28,
+	0x31, 0x25, 0xa8, 0x2b, 0xa9, 0x83, 0x4a, 0xf7, 0x1a, 0x77, 0x99, 0xb7, 0xef, 0x06, 0xde, 0xbe,
+	0xfb, 0x80, 0x78, 0xfb, 0xd8, 0xf0, 0x9e, 0x6a, 0xdc, 0x0a, 0xf2, 0x5b, 0x39, 0x85, 0xf2, 0x11,
+	0xf6, 0xb9, 0xcc, 0xce, 0x90, 0xd8, 0xc8, 0xe5, 0xea, 0xa1, 0xfb, 0x8b, 0xb3, 0x90, 0xfc, 0x3a,
+	0x6c, 0x5a, 0xb6, 0x39, 0x99, 0x0f, 0xb1, 0x1e, 0xd8, 0x4a, 0xd6, 0x2b, 0x68, 0x55, 0x8e, 0x6e,
+	0x33, 0xac, 0xf2, 0x6d, 0x1a, 0xaa, 0x47, 0xd8, 0x7f, 0x64, 0x79, 0xa1, 0x4d, 0x08, 0x32, 0x33,
+	0x63, 0x84, 0xb9, 0x50, 0xfa, 0x9b, 0xc4, 0x67, 0x62, 0x4d, 0x2d, 0x9f, 0x4a, 0xc9, 0x6a, 0x0c,
+	0x48, 0x5a, 0x25, 0x9d, 0xb4, 0x0a, 0xba, 0x09, 0x25, 0xd3, 0xf0, 0xf1, 0xc8, 0x71, 0x2f, 0x88,
+	0xba, 0x19, 0x2a, 0x04, 0x02, 0x54, 0x27, 0xca, 0x9a, 0xac, 0x90, 0x35, 0xfb, 0x50, 0x9c, 0x5a,
+	0xb6, 0xce, 0xf2, 0x22, 0x47, 0xf3, 0xa2, 0x30, 0xb5, 0xec, 0x1e, 0x81, 0x29, 0xd1, 0x38, 0xe7,
+	0xc4, 0x3c, 0x27, 0x1a, 0xe7, 0x8c, 0x78, 0x0d, 0x0a, 0x96, 0xad, 0x7b, 0xbe, 0x63, 0x3e, 0xa5,
+	0x21, 0x2e, 0x68, 0x79, 0xcb, 0xee, 0x13, 0x10, 0xbd, 0x0c, 0x95, 0x30, 0x75, 0xce, 0x7c, 0xec,
+	0xd2, 0x40, 0x17, 0xb5, 0x72, 0x90, 0x3d, 0x04, 0x87, 0x5e, 0x85, 0x6a, 0xc0, 0xf4, 0x04, 0x9f,
+	0x39, 0x2e, 0xae, 0x03, 0xe5, 0x0a, 0x3e, 0x3d, 0xa4, 0x48, 0xf4, 0x06, 0xe4, 0x3d, 0xc7, 0xf5,
+	0xf5, 0x27, 0x17, 0xf5, 0xd2, 0x81, 0x74, 0xbb, 0x7a, 0x0f, 0x85, 0xc1, 0xef, 0x3b, 0xae, 0x4f,
+	0x03, 0xa9, 0xe5, 0x08, 0xcb, 0xe1, 0x05, 0xba, 0x01, 0x40, 0x12, 0x1e, 0xdb, 0x43, 0xcb, 0x1e,
+	0xd5, 0xcb, 0x54, 0x2b, 0x01, 0x43, 0x03, 0x6a, 0x8c, 0xb0, 0xee, 0x3b, 0x4f, 0xb1, 0x5d, 0xaf,
+	0xb0, 0xa4, 0x25, 0x98, 0x01, 0x41, 0x10, 0x95, 0x02, 0x57, 0x9f, 0x19, 0x26, 0xf6, 0xbd, 0x7a,
+	0x95, 0x8a, 0xa8, 0x70, 0xec, 0x03, 0x8a, 0x44, 0xb7, 0xa0, 0x4c, 0x5d, 0xa2, 0x3f, 0x71, 0xe6,
+	0xf6, 0xd0, 0xab, 0x6f, 0x1e, 0xa4, 0x6f, 0xa7, 0xb4, 0x12, 0xc5, 0x1d, 0x52, 0x94, 0xf2, 0xab,
+	0x14, 0x6c, 0x86, 0x11, 0xf7, 0x66, 0x8e, 0xed, 0xd1, 0xf0, 0x9a, 0x74, 0x9f, 0x49, 0x34, 0xed,
+	0x19, 0x80, 0xde, 0x84, 0x02, 0xb7, 0xc7, 0xab, 0xa7, 0x0e, 0xd2, 0x89, 0xd9, 0x1d, 0x72, 0x90,
+	0x18, 0xfb, 0x8e, 0x6f, 0x4c, 0x74, 0x26, 0x29, 0x4d, 0x25, 0x01, 0x45, 0xb5, 0xa8, 0xb8, 0x90,
+	0x81, 0x58, 0xe5, 0x05, 0x49, 0x40, 0x51, 0x3d, 0x82, 0x21, 0x61, 0x1b, 0x1b, 0x9e, 0x6e, 0xe3,
+	0x73, 0xb6, 0xe1, 0x0b, 0x5a, 0x7e, 0x6c, 0x78, 0x5d, 0x7c, 0xee, 0xa3, 0xd7, 0x60, 0x93, 0xa0,
+	0x75, 0xc1, 0x45, 0x6c, 0xdb, 0x57, 0x08, 0xba, 0x17, 0xba, 0xe9, 0x75, 0xc8, 0x71, 0xf7, 0xe4,
+	0xe9, 0xf6, 0xda, 0x0c, 0x15, 0x66, 0x0e, 0xd2, 0x38, 0x59, 0xf9, 0x93, 0x04, 0x39, 0xee, 0xb3,
+	0x0f, 0x20, 0xc8, 0x44, 0x0b, 0x7b, 0x75, 0x89, 0x1a, 0xba, 0x1b, 0x7e, 0xd7, 0xe2, 0x49, 0x4a,
+	0x99, 0x35, 0x81, 0x13, 0x7d, 0x04, 0x15, 0xee, 0xeb, 0xb9, 0xf9, 0x14, 0x87, 0x3e, 0xaa, 0x09,
+	0x3e, 0x22, 0x5e, 0xa7, 0x44, 0xad, 0x3c, 0x8b, 0x00, 0x6f, 0x21, 0x41, 0x99, 0xa3, 0xc2, 0x04,
+	0x3d, 0x80, 0xb2, 0x33, 0xf7, 0x75, 0xe7, 0x8c, 0x93, 0x33, 0xcc, 0x8f, 0xce, 0xdc, 0x3f, 0x39,
+	0xa3, 0x1c, 0xca, 0x4f, 0xa1, 0xb2, 0xa0, 0x54, 0x7c, 0x77, 0x49, 0x2b, 0x77, 0x97, 0x58, 0x93,
+	0xc3, 0x90, 0xa7, 0x85, 0x90, 0x2b, 0x47, 0x50, 0x12, 0xb4, 0x46, 0x32, 0xa4, 0xa7, 0x96, 0x4d,
+	0x25, 0xa6, 0x34, 0xf2, 0x93, 0x62, 0x8c, 0xf3, 0x7a, 0x8a, 0x63, 0x8c, 0xf3, 0x15, 0x82, 0x14,
+	0xc8, 0xf5, 0x7d, 0xc3, 0x9f, 0x7b, 0xa4, 0xa8, 0x7a, 0x73, 0xd3, 0xc4, 0x9e, 0x47, 0xe5, 0x14,
+	0xb4, 0x00, 0x54, 0xbe, 0x86, 0x1a, 0x4f, 0xa3, 0x26, 0xad, 0xeb, 0x41, 0x01, 0x5a, 0x53, 0xdb,
+	0xf6, 0xa1, 0xc8, 0xce, 0x01, 0xb2, 0xf1, 0x58, 0x3d, 0x2a, 0x30, 0xc4, 0xe1, 0x05, 0xfa, 0x3f,
+	0x90, 0xf1, 0xf9, 0x0c, 0x9b, 0x64, 0xef, 0x06, 0xb5, 0x9c, 0x29, 0xb6, 0x19, 0xe0, 0x4f, 0x79,
+	0x4d, 0xff, 0x39, 0xec, 0xc4, 0x96, 0xe7, 0xbb, 0x61, 0x1f, 0x8a, 0x96, 0xa7, 0x63, 0xdb, 0x99,
+	0x8f, 0xc6, 0x5c, 0xe7, 0x82, 0xe5, 0xa9, 0x14, 0x16, 0x2b, 0x7e, 0x6a, 0x4d, 0xc5, 0x57, 0xc6,
+	0xb0, 0xdd, 0x1a, 0x63, 0xf3, 0x69, 0x4c, 0xfe, 0x1a, 0xfb, 0xa2, 0x53, 0x2f, 0xb5, 0x70, 0xea,
+	0x35, 0xa0, 0xe0, 0x62, 0x72, 0x76, 0xf3, 0x32, 0x9b, 0xd5, 0x42, 0x58, 0x79, 0x06, 0xdb, 0x1a,
+	0xfb, 0x4d, 0x73, 0xe4, 0x8a, 0x9e, 0x6c, 0x40, 0xe1, 0xd9, 0xdc, 0xb0, 0x7d, 0xcb, 0x0f, 0x1d,
+	0x19, 0xc0, 0x74, 0xb7, 0xfa, 0x13, 0xdd, 0xc3, 0xa6, 0x43, 0x0a, 0x49, 0x9a, 0xef, 0x56, 0x7f,
+	0xd2, 0x67, 0x18, 0xe5, 0xaf, 0x12, 0x94, 0xd8, 0x9a, 0x06, 0x3d, 0xc2, 0xe3, 0x8d, 0xc0, 0xe2,
+	0xda, 0xa9, 0xcb, 0xd6, 0x4e, 0xc7, 0xd6, 0xbe, 0x07, 0x39, 0x8f, 0x26, 0x0f, 0xcd, 0xfe, 0xea,
+	0xbd, 0x46, 0xe8, 0x62, 0x61, 0x41, 0x96, 0x5e, 0x1a, 0xe7, 0x24, 0xcb, 0xe1, 0xf3, 0x99, 0xe5,
+	0x62, 0x4f, 0x37, 0x58, 0xf9, 0x28, 0x6a, 0x45, 0x8e, 0x69, 0xae, 0x6b, 0x19, 0x94, 0x8f, 0x40,
+	0x3e, 0xc2, 0xbe, 0x20, 0xbd, 0x33, 0x24, 0x25, 0xd7, 0x8d, 0x10, 0x91, 0x03, 0x2b, 0xae, 0xc8,
+	0xa6, 0x98, 0xb0, 0x75, 0x38, 0xbf, 0x88, 0xf5, 0x05, 0x7b, 0x90, 0x9f, 0x7b, 0xd8, 0x0d, 0x3e,
+	0x2a, 0x6a, 0x39, 0x02, 0x76, 0xd6, 0x7a, 0x25, 0x8a, 0x7d, 0x5a, 0x8c, 0xbd, 0xf2, 0x00, 0x8a,
+	0x27, 0xee, 0x10, 0xbb, 0x8f, 0x2c, 0x1b, 0xbf, 0x40, 0x54, 0x95, 0xcf, 0x01, 0xb5, 0xa8, 0xd1,
+	0x54, 0xda, 0x5a, 0x6d, 0x6f, 0x43, 0xd6, 0xf2, 0xf1, 0x34, 0x28, 0x6d, 0xd1, 0xf9, 0x16, 0x2a,
+	0xa3, 0x31, 0x06, 0x05, 0x73, 0x05, 0x3b, 0x3e, 0x9e, 0xbe, 0x48, 0xda, 0x91, 0xde, 0xcd, 0xb6,
+	0x7c, 0x7e, 0xb0, 0xa7, 0x69, 0x99, 0x29, 0x12, 0x0c, 0x2d, 0x4b, 0xca, 0x6f, 0xd3, 0x90, 0xa5,
+	0xeb, 0x2c, 0xa5, 0x9b, 0x60, 0x43, 0x2a, 0xd9, 0x86, 0x74, 0x92, 0x0d, 0x44, 0x5f, 0x6e, 0x83,
+	0x70, 0x40, 0x09, 0xad, 0x28, 0x3f, 0xa0, 0x08, 0x86, 0x64, 0x04, 0x63, 0x08, 0xd5, 0x67, 0x7d,
+	0x69, 0x85, 0x62, 0x3f, 0x13, 0x6c, 0xb8, 0xac, 0x3d, 0x7d, 0x33, 0xcc, 0xee, 0x3c, 0xcd, 0xee,
+	0xda, 0xa2, 0x46, 0xb1, 0xbc, 0xde, 0x83, 0xfc, 0xcc, 0xb0, 0xa8, 0x24, 0xd6, 0xad, 0xe6, 0x08,
+	0xc8, 0x32, 0xda, 0x1b, 0x5b, 0xb3, 0x19, 0x5b, 0x85, 0xb5, 0x31, 0x45, 0x8e, 0x69, 0xfa, 0xa4,
+	0x13, 0x18, 0xe2, 0x89, 0xf5, 0x1c, 0xbb, 0x8c, 0x01, 0x82, 0xa6, 0x9b, 0xe3, 0x18, 0x8b, 0x69,
+	0xd8, 0x26, 0x9e, 0x4c, 0x18, 0x4b, 0x89, 0xb1, 0x84, 0xb8, 0x26, 0x3d, 0x5a, 0x5c, 0x7c, 0x36,
+	0xb7, 0x87, 0x8c, 0xa3, 0x4c, 0x39, 0x20, 0x40, 0x35, 0x7d, 0xc5, 0x80, 0xdd, 0x81, 0x6b, 0xd8,
+	0x9e, 0x45, 0x76, 0xc3, 0x42, 0x52, 0x5d, 0x83, 0x82, 0xe3, 0x0e, 0x59, 0x44, 0x58, 0x98, 0xf2,
+	0x14, 0xee, 0x0c, 0x05, 0x0f, 0xa4, 0xd6, 0x7b, 0x40, 0xf9, 0xa3, 0x04, 0xbb, 0x1a, 0xf6, 0xe7,
+	0xae, 0x1d, 0x45, 0x6c, 0xfd, 0x1a, 0x2f, 0x50, 0x7e, 0xde, 0x82, 0x9c, 0x8b, 0x0d, 0xcf, 0xb1,
+	0x79, 0xf9, 0xd9, 0x11, 0xca, 0x0f, 0x51, 0x43, 0xa3, 0x44, 0x8d, 0x33, 0x91, 0x03, 0xce, 0xc5,
+	0xec, 0xb0, 0xe6, 0x5d, 0x0b, 0x07, 0x95, 0x5f, 0xa7, 0xa0, 0xc4, 0x7d, 0x42, 0xbe, 0x5b, 0xca,
+	0x59, 0x51, 0xfd, 0xd4, 0x65, 0xea, 0xa7, 0x2f, 0x53, 0x3f, 0xb3, 0x52, 0xfd, 0xec, 0x55, 0xd4,
+	0xbf, 0x0e, 0x45, 0xae, 0x2f, 0x1e, 0xd2, 0x64, 0x2d, 0x68, 0x11, 0x82, 0xf4, 0xcb, 0x2c, 0xda,
+	0x3a, 0xaf, 0x4b, 0xac, 0xd7, 0x2e, 0x33, 0x64, 0x33, 0xe9, 0x3e, 0x56, 0x88, 0x17, 0xd7, 0xd7,
+	0x01, 0x8e, 0xb0, 0x7f, 0xc2, 0x2d, 0x5b, 0x1d, 0x33, 0xe5, 0x4b, 0xd8, 0x23, 0x6d, 0x29, 0xe5,
+	0xf4, 0x0e, 0x2f, 0x1e, 0x7b, 0x57, 0x28, 0x51, 0xc1, 0x6d, 0x25, 0x95, 0x74, 0x5b, 0x49, 0x0b,
+	0xb7, 0x15, 0xe5, 0x2b, 0x40, 0x91, 0xf4, 0xf0, 0x30, 0x7e, 0x0d, 0x72, 0x74, 0xf9, 0xa0, 0xf3,
+	0xab, 0x2e, 0xe6, 0xa2, 0xc6, 0xa9, 0xf1, 0xf6, 0x36, 0x15, 0x6f, 0x6f, 0x95, 0xff, 0x48, 0x50,
+	0xa1, 0x87, 0xef, 0xb1, 0xf3, 0x1c, 0x4f, 0xb1, 0x2d, 0x5e, 0x8d, 0xd3, 0x57, 0x39, 0x11, 0x6b,
+	0x90, 0x1d, 0xe2, 0x89, 0x6f, 0x04, 0x5a, 0x53, 0x00, 0xbd, 0x17, 0x4b, 0xc6, 0xeb, 0xd1, 0x1d,
+	0x43, 0x5c, 0x2c, 0x16, 0xd4, 0x1a, 0x64, 0x0d, 0xd3, 0x77, 0x5c, 0x7e, 0x10, 0x32, 0x80, 0x85,
+	0xfa, 0x0c, 0xbb, 0xd8, 0xe6, 0x37, 0xaa, 0xa2, 0x16, 0x21, 0x48, 0x1e, 0x3f, 0x31, 0x26, 0x64,
+	0xf3, 0xd3, 0x20, 0x67, 0xb5, 0x00, 0x5c, 0x17, 0xdf, 0xdf, 0x48, 0x70, 0x8d, 0x78, 0x76, 0x41,
+	0x21, 0xef, 0x8a, 0x3d, 0x08, 0x82, 0xcc, 0x99, 0xeb, 0x4c, 0x83, 0xde, 0x94, 0xfc, 0x26, 0x8e,
+	0xf3, 0x1d, 0x3e, 0x26, 0x48, 0xf9, 0x4e, 0x18, 0xe3, 0x4c, 0x52, 0x8c, 0xb3, 0x62, 0x8c, 0x3d,
+	0x68, 0x24, 0x69, 0xc2, 0x63, 0xfd, 0x1e, 0x14, 0xa7, 0x01, 0x72, 0xa9, 0xd1, 0x5f, 0x74, 0x67,
+	0xc4, 0xb8, 0x3e, 0xf2, 0x1f, 0xc0, 0x8e, 0x46, 0x9a, 0x22, 0xd3, 0x9a, 0x7c, 0x97, 0xf6, 0x4b,
+	0xb1, 0x41, 0xa6, 0xec, 0x6d, 0xcb, 0x33, 0x5d, 0x3c, 0x33, 0x6c, 0xf3, 0xe2, 0xfb, 0xf6, 0x86,
+	0x2f, 0x43, 0x65, 0x82, 0x87, 0x23, 0xec, 0xea, 0x0b, 0xed, 0x43, 0x99, 0x21, 0xd9, 0x36, 0x55,
+	0x3c, 0xd8, 0x8d, 0xeb, 0xc9, 0x1d, 0x53, 0x87, 0xbc, 0x49, 0x1a, 0x55, 0x1c, 0xa4, 0x6b, 0x00,
+	0xa2, 0x4f, 0xa0, 0x32, 0x0c, 0xd5, 0xb3, 0x70, 0xd0, 0x09, 0x5c, 0x5b, 0x74, 0x9b, 0x60, 0x81,
+	0xb6, 0xc8, 0xaf, 0x98, 0xb0, 0x17, 0x0d, 0x2e, 0xbc, 0xc3, 0x8b, 0xce, 0x30, 0xcc, 0x8c, 0x9b,
+	0x50, 0x8a, 0x6c, 0x65, 0x01, 0xc9, 0x6a, 0x10, 0x1a, 0xeb, 0x5d, 0x7d, 0x8a, 0x61, 0x41, 0x7d,
+	0x79, 0x11, 0x6e, 0x9b, 0x78, 0x8b, 0x95, 0xae, 0x72, 0x8b, 0x9d, 0x5a, 0x9e, 0x67, 0xd9, 0x23,
+	0xaa, 0x53, 0x8a, 0xe9, 0xc4, 0x51, 0x9d, 0xa1, 0xa7, 0xbc, 0x02, 0xc5, 0x23, 0xec, 0x93, 0xd2,
+	0xd4, 0x69, 0xaf, 0xac, 0x4a, 0xca, 0xbf, 0x25, 0x28, 0xf4, 0xe6, 0xae, 0x39, 0x36, 0x3c, 0xfc,
+	0x03, 0x9d, 0x52, 0x6f, 0xc0, 0x96, 0x4b, 0xeb, 0x39, 0x1e, 0xea, 0xb1, 0xb3, 0x40, 0x0e, 0x08,
+	0x61, 0x4b, 0x72, 0x0b, 0xca, 0x36, 0xf6, 0xe3, 0x7d, 0x4b, 0xc9, 0xc6, 0xfe, 0x67, 0xc9, 0x9d,
+	0x57, 0x2e, 0xd6, 0x79, 0xc5, 0x6a, 0x40, 0x3e, 0x5e, 0x03, 0xbe, 0x86, 0xeb, 0x24, 0x02, 0xdc,
+	0xe4, 0x61, 0x10, 0x8a, 0xef, 0x19, 0x85, 0xb7, 0xa1, 0x38, 0xe3, 0xa2, 0x82, 0x8c, 0xdb, 0x8a,
+	0xd8, 0x39, 0x45, 0x8b, 0x78, 0x94, 0x8f, 0x61, 0xbf, 0x37, 0x77, 0x47, 0x41, 0x42, 0x44, 0xcb,
+	0x87, 0x1b, 0xd1, 0x99, 0x90, 0x08, 0xf8, 0x63, 0xc3, 0xe6, 0xa1, 0x2a, 0x52, 0xcc, 0x60, 0x6c,
+	0xd8, 0xca, 0x9f, 0x25, 0x28, 0x04, 0x57, 0xea, 0xa5, 0x43, 0x7a, 0x1f, 0x8a, 0x33, 0xc3, 0xc5,
+	0xb6, 0x10, 0xa1, 0x02, 0x43, 0x08, 0x37, 0xeb, 0xb4, 0x70, 0xb3, 0x46, 0x90, 0xf1, 0x26, 0xf3,
+	0x11, 0x8d, 0x45, 0x51, 0xa3, 0xbf, 0x49, 0x20, 0x67, 0x0e, 0x6b, 0x92, 0xb8, 0xef, 0x43, 0xf8,
+	0xc5, 0xa6, 0x99, 0xca, 0xff, 0x43, 0xe5, 0x08, 0xfb, 0xad, 0xe8, 0xb2, 0xbf, 0x6e, 0x1a, 0xa0,
+	0x68, 0xb0, 0x43, 0x6a, 0x64, 0x2b, 0x9c, 0x64, 0x04, 0x5e, 0x5a, 0xb0, 0x54, 0x8a, 0x59, 0x4a,
+	0xcf, 0x0e, 0x73, 0xee, 0x7a, 0xd6, 0x73, 0xcc, 0x77, 0x61, 0x84, 0x50, 0x7e, 0x0c, 0xbb, 0x71,
+	0x99, 0x3c, 0xf0, 0xef, 0x24, 0x4c, 0x57, 0xb6, 0x96, 0xa6, 0x2b, 0xe2, 0x60, 0x45, 0xf9, 0x12,
+	0xea, 0x3c, 0x80, 0xcb, 0x3a, 0xae, 0xa9, 0x8f, 0xb4, 0xa5, 0x0d, 0x8d, 0x0f, 0xf6, 0x6f, 0x29,
+	0xb2, 0xde, 0x53, 0xfe, 0x29, 0xc1, 0x4e, 0x1f, 0x1b, 0xae, 0x39, 0x8e, 0x67, 0x49, 0x0d, 0xb2,
+	0xcf, 0xe6, 0xd8, 0xbd, 0xe0, 0x09, 0xc2, 0x80, 0xab, 0x37, 0x18, 0xb1, 0x11, 0x5e, 0x26, 0x3e,
+	0xc2, 0x8b, 0x05, 0x26, 0xbb, 0x34, 0xa6, 0x59, 0x9e, 0xf1, 0xe5, 0xae, 0x32, 0xe3, 0xcb, 0x2f,
+	0xcf, 0xf8, 0xfe, 0x20, 0x41, 0x91, 0xd9, 0xf8, 0xd0, 0xfa, 0x6e, 0x43, 0xea, 0x1a, 0x64, 0x3d,
+	0xd3, 0x71, 0x31, 0x9f, 0xf0, 0x30, 0x80, 0x68, 0x46, 0x52, 0x5b, 0x1f, 0x5b, 0xa3, 0xf1, 0xc4,
+	0x1a, 0x8d, 0x7d, 0x9e, 0xf0, 0x15, 0x82, 0x7d, 0x18, 0x20, 0xd1, 0xbb, 0xb0, 0x23, 0x0c, 0xf5,
+	0x05, 0x6e, 0xe6, 0x8b, 0x9a, 0x40, 0x0c, 0x3f, 0x22, 0x4d, 0xf2, 0x6e, 0x3c, 0x1e, 0x97, 0x8e,
+	0x25, 0x5f, 0x83, 0xcc, 0xd8, 0xf2, 0x97, 0xef, 0xa4, 0xa1, 0xc1, 0x1a, 0xa5, 0xff, 0x8f, 0x0d,
+	0x24, 0x0d, 0xd8, 0xed, 0xcf, 0x47, 0x23, 0xec, 0xf9, 0xf1, 0xb4, 0xdc, 0x85, 0xdc, 0xcc, 0xc5,
+	0x67, 0xd6, 0x79, 0x70, 0xc6, 0x30, 0x68, 0xc5, 0x4c, 0x3e, 0x3e, 0x5a, 0x8c, 0x66, 0xdf, 0xca,
+	0x27, 0x00, 0x7c, 0x09, 0x5e, 0x76, 0xd6, 0xf4, 0x65, 0xf1, 0x99, 0xa1, 0xd2, 0x83, 0xbd, 0x25,
+	0x1d, 0x79, 0xa8, 0xde, 0x87, 0x92, 0x17, 0xca, 0x0e, 0xf6, 0xf9, 0x76, 0x14, 0x9b, 0x90, 0xa6,
+	0x89, 0x7c, 0xca, 0x07, 0x70, 0x3d, 0xb9, 0x6e, 0x73, 0xb1, 0xc4, 0x76, 0x42, 0x0f, 0xfa, 0x12,
+	0x0e, 0xdd, 0xf9, 0x0a, 0x8a, 0xe1, 0x88, 0x1d, 0x95, 0x20, 0xdf, 0x3f, 0xd1, 0x06, 0x7a, 0xa7,
+	0x2d, 0x6f, 0xa0, 0x2a, 0x00, 0x05, 0x7a, 0x5a, 0xa7, 0xa5, 0xca, 0x12, 0xaa, 0x40, 0x91, 0xc2,
+	0xdd, 0xe6, 0xb1, 0x2a, 0xa7, 0xd0, 0x36, 0x6c, 0x52, 0xb0, 0xa5, 0xa9, 0xcd, 0x81, 0xda, 0xd6,
+	0x9b, 0x03, 0x39, 0x8d, 0x36, 0xa1, 0x44, 0x91, 0xcd, 0xe3, 0x93, 0xc7, 0xdd, 0x81, 0x9c, 0xb9,
+	0x73, 0x01, 0x5b, 0x4b, 0x93, 0x26, 0xb4, 0x0b, 0x48, 0x53, 0xfb, 0xaa, 0x76, 0xda, 0x1c, 0x74,
+	0x4e, 0xba, 0x7a, 0xb3, 0x35, 0xe8, 0x9c, 0xaa, 0xf2, 0x06, 0xba, 0x06, 0x3b, 0x22, 0xbe, 0x75,
+	0x72, 0x7c, 0xdc, 0x19, 0x0c, 0xd4, 0xb6, 0x2c, 0xa1, 0x3a, 0xd4, 0x44, 0x92, 0xa6, 0x3e, 0x52,
+	0x9b, 0x7d, 0xb5, 0x2d, 0xa7, 0xd0, 0x1e, 0x6c, 0x8b, 0x14, 0xf5, 0x8b, 0x5e, 0x47, 0x53, 0xdb,
+	0x72, 0xfa, 0xce, 0x2f, 0x25, 0x7e, 0x67, 0xe4, 0xab, 0x6e, 0x41, 0xe5, 0x44, 0x6b, 0xab, 0x9a,
+	0xde, 0x53, 0xbb, 0xed, 0x4e, 0xf7, 0x88, 0x99, 0xc8, 0x51, 0xcd, 0x0e, 0x59, 0x25, 0x64, 0xe9,
+	0x3f, 0xec, 0xf4, 0x7a, 0x54, 0xfc, 0x36, 0x6c, 0x32, 0x54, 0x5b, 0x7d, 0xd4, 0x39, 0x55, 0xa9,
+	0xe8, 0x08, 0xd9, 0x6a, 0x76, 0x5b, 0xea, 0xa3, 0x47, 0x6a, 0x5b, 0xce, 0x20, 0x04, 0x55, 0x86,
+	0xd4, 0xd4, 0x07, 0x8f, 0xbb, 0x6d, 0xb5, 0x2d, 0x67, 0x89, 0x0e, 0x65, 0xf1, 0xae, 0x88, 0x64,
+	0x28, 0x6b, 0xea, 0xe0, 0xb1, 0xd6, 0xd5, 0x4f, 0x06, 0x0f, 0x55, 0x4d, 0xde, 0x20, 0x9f, 0x71,
+	0x4c, 0xbb, 0x79, 0xdc, 0x3c, 0xa2, 0xd6, 0xee, 0xc0, 0x16, 0xc7, 0x7d, 0xae, 0x9d, 0x74, 0x8f,
+	0xf4, 0xce, 0x40, 0x3d, 0x96, 0x53, 0x68, 0x1f, 0xf6, 0x38, 0xba, 0x7b, 0x32, 0xd0, 0x9b, 0x7d,
+	0xbd, 0xad, 0xf6, 0x5b, 0x5a, 0xe7, 0x90, 0xea, 0x44, 0xfd, 0x40, 0x89, 0xad, 0x87, 0xcd, 0xee,
+	0x91, 0xda, 0xd6, 0x8f, 0x3b, 0xdd, 0xb6, 0x9c, 0xb9, 0xf3, 0x37, 0x09, 0xb6, 0x13, 0x6e, 0x38,
+	0xa8, 0x06, 0xf2, 0xf1, 0xc9, 0xa9, 0x7a, 0xac, 0x76, 0x07, 0xfa, 0x49, 0x4f, 0xed, 0x32, 0x97,
+	0xec, 0xc0, 0x56, 0x88, 0xed, 0x74, 0x49, 0x70, 0xfb, 0xaa, 0x2c, 0x2d, 0xa0, 0xdb, 0x2a, 0x47,
+	0x53, 0xe7, 0x87, 0xe8, 0x66, 0xfb, 0xd3, 0xc7, 0xfd, 0x01, 0xf9, 0x29, 0xa7, 0x89, 0x27, 0x43,
+	0x42, 0xbf, 0xf9, 0x48, 0x95, 0x33, 0x24, 0xba, 0x21, 0x8a, 0xfb, 0x8d, 0x86, 0x4c, 0xce, 0x12,
+	0x7f, 0x86, 0x24, 0x66, 0x84, 0x9c, 0x23, 0x21, 0x17, 0x90, 0x61, 0x84, 0xe5, 0xfc, 0xbd, 0xdf,
+	0x6d, 0x43, 0x95, 0x27, 0x78, 0x9f, 0xbd, 0xc0, 0xa2, 0xf7, 0xa1, 0xc2, 0xc6, 0x71, 0x1c, 0x8f,
+	0x96, 0x2a, 0x73, 0x63, 0x09, 0xa3, 0x6c, 0xa0, 0xfb, 0xf4, 0xcd, 0x8e, 0xc3, 0xa4, 0xdb, 0x45,
+	0xd1, 0x1d, 0x5f, 0x7c, 0x25, 0x4c, 0xfc, 0xf8, 0x27, 0x74, 0xd4, 0xb9, 0xd0, 0x2b, 0xa3, 0x83,
+	0x84, 0xcf, 0x17, 0x7a, 0xf5, 0xc6, 0xad, 0x4b, 0x38, 0xd8, 0x5e, 0x55, 0x36, 0x88, 0x39, 0x0b,
+	0xaf, 0xa4, 0x57, 0x34, 0xa7, 0x09, 0xe5, 0x9e, 0xe1, 0x87, 0xf5, 0x1f, 0xbd, 0x14, 0xf2, 0x24,
+	0xbd, 0xb9, 0x26, 0x8a, 0xf8, 0x08, 0x2a, 0xac, 0x84, 0x04, 0x32, 0x56, 0x38, 0x64, 0x53, 0xb8,
+	0xb7, 0xd0, 0xe1, 0xd2, 0x06, 0x6a, 0x41, 0x99, 0xb4, 0x2e, 0x81, 0x4d, 0x68, 0x4f, 0xfc, 0x52,
+	0x78, 0x17, 0x6d, 0xd4, 0x97, 0x09, 0xa1, 0xe5, 0x5f, 0xc0, 0x4e, 0xc7, 0x26, 0x3d, 0x9b, 0x87,
+	0x17, 0xde, 0x14, 0x04, 0x5b, 0x92, 0x9e, 0x3a, 0x1a, 0x37, 0x56, 0x91, 0x45, 0xc9, 0x6d, 0xfc,
+	0x83, 0x48, 0x6e, 0x43, 0x49, 0x78, 0x9d, 0x58, 0xe5, 0xb1, 0x68, 0xde, 0x90, 0xf0, 0x94, 0xa1,
+	0x6c, 0xa0, 0x8f, 0x01, 0xa2, 0xf1, 0x37, 0x8a, 0x26, 0xf5, 0x4b, 0x33, 0xf1, 0xc4, 0xb8, 0xfd,
+	0x2c, 0xf9, 0xda, 0xc0, 0x66, 0x3f, 0xe4, 0x14, 0x12, 0x95, 0x62, 0x97, 0xae, 0xc6, 0xab, 0x0b,
+	0x8a, 0xae, 0xba, 0x71, 0x50, 0xd5, 0x4a, 0xc2, 0xb0, 0x1b, 0xed, 0x47, 0x96, 0x2c, 0x8d, 0xc0,
+	0x1b, 0xb1, 0xb1, 0x8f, 0xb2, 0x81, 0xde, 0x81, 0x42, 0x30, 0xb5, 0x42, 0xdb, 0xe2, 0x92, 0x7c,
+	0x90, 0x95, 0xf0, 0x49, 0x1f, 0xe4, 0xf8, 0xfc, 0x4a, 0xd8, 0x5a, 0x2b, 0x46, 0x5b, 0x8d, 0xfd,
+	0x04, 0x8e, 0x85, 0x30, 0x6d, 0xc6, 0x26, 0xac, 0xe8, 0x66, 0xf8, 0x45, 0xf2, 0xec, 0x35, 0x41,
+	0xb5, 0x4f, 0x61, 0x33, 0x36, 0x43, 0x15, 0xa4, 0x24, 0x4f, 0x57, 0x1b, 0xb1, 0xb1, 0x2c, 0xe3,
+	0xa2, 0x1a, 0x95, 0xc5, 0xc7, 0x26, 0x74, 0x3d, 0xf6, 0x3c, 0xb3, 0x30, 0x04, 0x69, 0xd4, 0x62,
+	0x54, 0x7a, 0xa4, 0x2a, 0x1b, 0xe8, 0x01, 0x6c, 0xb5, 0x9c, 0xe9, 0xd4, 0x12, 0x5f, 0x5d, 0xd0,
+	0x35, 0xd1, 0xd1, 0x0b, 0xcf, 0x31, 0x2b, 0xe5, 0x1c, 0x01, 0xd2, 0xf0, 0x84, 0xec, 0x8f, 0x17,
+	0x14, 0xa4, 0xb3, 0xf9, 0xe0, 0xe2, 0xec, 0x08, 0x29, 0x0b, 0xd1, 0x49, 0x1c, 0x71, 0x35, 0x5e,
+	0xbe, 0x94, 0x27, 0x8c, 0x64, 0x1f, 0xaa, 0x8b, 0xf3, 0x17, 0x74, 0x43, 0x50, 0x25, 0x61, 0x80,
+	0xd4, 0xb8, 0xb9, 0x92, 0x1e, 0x0a, 0xbd, 0x4f, 0x84, 0x7a, 0xbe, 0xe3, 0xae, 0x2b, 0x7d, 0x49,
+	0xdb, 0x0f, 0x43, 0x2d, 0xa9, 0xfd, 0x42, 0xaf, 0x88, 0x97, 0xed, 0x55, 0xb7, 0xea, 0xc6, 0xab,
+	0x6b, 0xb8, 0x44, 0xc3, 0x17, 0x3b, 0x7c, 0xc1, 0xf0, 0xc4, 0xab, 0x58, 0xe3, 0xe6, 0x4a, 0x7a,
+	0x28, 0xf4, 0x14, 0x36, 0x63, 0xcd, 0xa8, 0x90, 0xd1, 0xc9, 0xad, 0x74, 0xe3, 0x60, 0x35, 0x43,
+	0x28, 0xf7, 0x43, 0xa8, 0xb2, 0xfa, 0x10, 0x4e, 0x04, 0x96, 0xaf, 0xab, 0x8d, 0x65, 0x94, 0xb2,
+	0x81, 0x7e, 0x04, 0x25, 0xe1, 0x2a, 0x8e, 0x76, 0xc5, 0x38, 0x44, 0x17, 0xf4, 0xe4, 0x6f, 0x3f,
+	0x84, 0x2a, 0x3b, 0xec, 0xbe, 0xf3, 0xaa, 0xf7, 0xa1, 0xca, 0x3c, 0xbf, 0x76, 0xe1, 0x84, 0xc3,
+	0xaf, 0x0f, 0xd5, 0xc5, 0x7b, 0xbb, 0x10, 0x99, 0xc4, 0x21, 0x41, 0xe3, 0xe6, 0x4a, 0x7a, 0xe8,
+	0xc1, 0x2f, 0xa1, 0xd6, 0x0f, 0x33, 0x4f, 0x10, 0x7d, 0x2b, 0x9e, 0x81, 0xdf, 0x4b, 0x7a, 0x0f,
+	0x6a, 0x47, 0x49, 0xd2, 0x57, 0xa4, 0xfd, 0x7a, 0x89, 0x87, 0xf2, 0x5f, 0xbe, 0xb9, 0x21, 0xfd,
+	0xfd, 0x9b, 0x1b, 0xd2, 0x3f, 0xbe, 0xb9, 0x21, 0xfd, 0xfe, 0x5f, 0x37, 0x36, 0x9e, 0xe4, 0xe8,
+	0xbf, 0xb1, 0xde, 0xfd, 0xef, 0x00, 0x38, 0x85, 0x59, 0xbf, 0x33, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProductServiceClient is the client API for ProductService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProductServiceClient interface {
+	CreateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error)
+	GetProductById(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Product, error)
+	GetProductsByIds(ctx context.Context, in *GetProductsByIdsRequest, opts ...grpc.CallOption) (*GetProductsByIdsResponse, error)
+	UpdateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error)
+	// PatchProduct writes only the fields named in the update mask.
+	PatchProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error)
+	DeleteProduct(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Status, error)
+	ListProducts(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*GetListResponse, error)
+	IncreaseProductAmount(ctx context.Context, in *ProductAmountRequest, opts ...grpc.CallOption) (*ProductAmountResponse, error)
+	DecreaseProductAmount(ctx context.Context, in *ProductAmountRequest, opts ...grpc.CallOption) (*ProductAmountResponse, error)
+	CheckAmount(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*CheckAmountResponse, error)
+	BuyProduct(ctx context.Context, in *BuyProductRequest, opts ...grpc.CallOption) (*Product, error)
+	GetPurchasedProductsByUserId(ctx context.Context, in *GetUserID, opts ...grpc.CallOption) (*GetPurchasedProductsResponse, error)
+	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	GetOrder(ctx context.Context, in *GetOrderId, opts ...grpc.CallOption) (*Order, error)
+	ListOrdersByUser(ctx context.Context, in *ListOrdersByUserRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	TransitionOrder(ctx context.Context, in *TransitionOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	ReturnOrderItem(ctx context.Context, in *ReturnOrderItemRequest, opts ...grpc.CallOption) (*OrderReturn, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*Reservation, error)
+	CommitReservation(ctx context.Context, in *GetReservationId, opts ...grpc.CallOption) (*Reservation, error)
+	ReleaseReservation(ctx context.Context, in *GetReservationId, opts ...grpc.CallOption) (*Reservation, error)
+	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error)
+	RestoreProduct(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Product, error)
+	PurgeDeletedProducts(ctx context.Context, in *PurgeDeletedProductsRequest, opts ...grpc.CallOption) (*PurgeDeletedProductsResponse, error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
+	SuggestProducts(ctx context.Context, in *SuggestProductsRequest, opts ...grpc.CallOption) (*SuggestProductsResponse, error)
+	CreateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error)
+	GetCategory(ctx context.Context, in *GetCategoryId, opts ...grpc.CallOption) (*Category, error)
+	UpdateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error)
+	DeleteCategory(ctx context.Context, in *GetCategoryId, opts ...grpc.CallOption) (*Status, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	SetProductCategories(ctx context.Context, in *ProductCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	GetProductCategories(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+}
+
+type productServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProductServiceClient(cc *grpc.ClientConn) ProductServiceClient {
+	return &productServiceClient{cc}
+}
+
+func (c *productServiceClient) CreateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error) {
+	out := new(Product)
+	err := c.cc.Invoke(ctx, "/product.ProductService/CreateProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductById(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Product, error) {
+	out := new(Product)
+	err := c.cc.Invoke(ctx, "/product.ProductService/GetProductById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductsByIds(ctx context.Context, in *GetProductsByIdsRequest, opts ...grpc.CallOption) (*GetProductsByIdsResponse, error) {
+	out := new(GetProductsByIdsResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/GetProductsByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error) {
+	out := new(Product)
+	err := c.cc.Invoke(ctx, "/product.ProductService/UpdateProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PatchProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	out := new(Product)
+	err := c.cc.Invoke(ctx, "/product.ProductService/PatchProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProduct(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/product.ProductService/DeleteProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProducts(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*GetListResponse, error) {
+	out := new(GetListResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/ListProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) IncreaseProductAmount(ctx context.Context, in *ProductAmountRequest, opts ...grpc.CallOption) (*ProductAmountResponse, error) {
+	out := new(ProductAmountResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/IncreaseProductAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DecreaseProductAmount(ctx context.Context, in *ProductAmountRequest, opts ...grpc.CallOption) (*ProductAmountResponse, error) {
+	out := new(ProductAmountResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/DecreaseProductAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CheckAmount(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*CheckAmountResponse, error) {
+	out := new(CheckAmountResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/CheckAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BuyProduct(ctx context.Context, in *BuyProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	out := new(Product)
+	err := c.cc.Invoke(ctx, "/product.ProductService/BuyProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPurchasedProductsByUserId(ctx context.Context, in *GetUserID, opts ...grpc.CallOption) (*GetPurchasedProductsResponse, error) {
+	out := new(GetPurchasedProductsResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/GetPurchasedProductsByUserId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/product.ProductService/CreateOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetOrder(ctx context.Context, in *GetOrderId, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/product.ProductService/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListOrdersByUser(ctx context.Context, in *ListOrdersByUserRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/ListOrdersByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) TransitionOrder(ctx context.Context, in *TransitionOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/product.ProductService/TransitionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReturnOrderItem(ctx context.Context, in *ReturnOrderItemRequest, opts ...grpc.CallOption) (*OrderReturn, error) {
+	out := new(OrderReturn)
+	err := c.cc.Invoke(ctx, "/product.ProductService/ReturnOrderItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/product.ProductService/ReserveStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CommitReservation(ctx context.Context, in *GetReservationId, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/product.ProductService/CommitReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReleaseReservation(ctx context.Context, in *GetReservationId, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/product.ProductService/ReleaseReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
+	out := new(ListStockMovementsResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/ListStockMovements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error) {
+	out := new(ReconcileStockResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/ReconcileStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RestoreProduct(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*Product, error) {
+	out := new(Product)
+	err := c.cc.Invoke(ctx, "/product.ProductService/RestoreProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PurgeDeletedProducts(ctx context.Context, in *PurgeDeletedProductsRequest, opts ...grpc.CallOption) (*PurgeDeletedProductsResponse, error) {
+	out := new(PurgeDeletedProductsResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/PurgeDeletedProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/SearchProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SuggestProducts(ctx context.Context, in *SuggestProductsRequest, opts ...grpc.CallOption) (*SuggestProductsResponse, error) {
+	out := new(SuggestProductsResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/SuggestProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, "/product.ProductService/CreateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCategory(ctx context.Context, in *GetCategoryId, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, "/product.ProductService/GetCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, "/product.ProductService/UpdateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteCategory(ctx context.Context, in *GetCategoryId, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/product.ProductService/DeleteCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/ListCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductCategories(ctx context.Context, in *ProductCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/SetProductCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductCategories(ctx context.Context, in *GetProductId, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/product.ProductService/GetProductCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServiceServer is the server API for ProductService service.
+type ProductServiceServer interface {
+	CreateProduct(context.Context, *Product) (*Product, error)
+	GetProductById(context.Context, *GetProductId) (*Product, error)
+	GetProductsByIds(context.Context, *GetProductsByIdsRequest) (*GetProductsByIdsResponse, error)
+	UpdateProduct(context.Context, *Product) (*Product, error)
+	// PatchProduct writes only the fields named in the update mask.
+	PatchProduct(context.Context, *UpdateProductRequest) (*Product, error)
+	DeleteProduct(context.Context, *GetProductId) (*Status, error)
+	ListProducts(context.Context, *GetListRequest) (*GetListResponse, error)
+	IncreaseProductAmount(context.Context, *ProductAmountRequest) (*ProductAmountResponse, error)
+	DecreaseProductAmount(context.Context, *ProductAmountRequest) (*ProductAmountResponse, error)
+	CheckAmount(context.Context, *GetProductId) (*CheckAmountResponse, error)
+	BuyProduct(context.Context, *BuyProductRequest) (*Product, error)
+	GetPurchasedProductsByUserId(context.Context, *GetUserID) (*GetPurchasedProductsResponse, error)
+	CreateOrder(context.Context, *CreateOrderRequest) (*Order, error)
+	GetOrder(context.Context, *GetOrderId) (*Order, error)
+	ListOrdersByUser(context.Context, *ListOrdersByUserRequest) (*ListOrdersResponse, error)
+	TransitionOrder(context.Context, *TransitionOrderRequest) (*Order, error)
+	ReturnOrderItem(context.Context, *ReturnOrderItemRequest) (*OrderReturn, error)
+	ReserveStock(context.Context, *ReserveStockRequest) (*Reservation, error)
+	CommitReservation(context.Context, *GetReservationId) (*Reservation, error)
+	ReleaseReservation(context.Context, *GetReservationId) (*Reservation, error)
+	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	ReconcileStock(context.Context, *ReconcileStockRequest) (*ReconcileStockResponse, error)
+	RestoreProduct(context.Context, *GetProductId) (*Product, error)
+	PurgeDeletedProducts(context.Context, *PurgeDeletedProductsRequest) (*PurgeDeletedProductsResponse, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	SuggestProducts(context.Context, *SuggestProductsRequest) (*SuggestProductsResponse, error)
+	CreateCategory(context.Context, *Category) (*Category, error)
+	GetCategory(context.Context, *GetCategoryId) (*Category, error)
+	UpdateCategory(context.Context, *Category) (*Category, error)
+	DeleteCategory(context.Context, *GetCategoryId) (*Status, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	SetProductCategories(context.Context, *ProductCategoriesRequest) (*ListCategoriesResponse, error)
+	GetProductCategories(context.Context, *GetProductId) (*ListCategoriesResponse, error)
+}
+
+// UnimplementedProductServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProductServiceServer struct {
+}
+
+func (*UnimplementedProductServiceServer) CreateProduct(ctx context.Context, req *Product) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (*UnimplementedProductServiceServer) GetProductById(ctx context.Context, req *GetProductId) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductById not implemented")
+}
+func (*UnimplementedProductServiceServer) GetProductsByIds(ctx context.Context, req *GetProductsByIdsRequest) (*GetProductsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductsByIds not implemented")
+}
+func (*UnimplementedProductServiceServer) UpdateProduct(ctx context.Context, req *Product) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (*UnimplementedProductServiceServer) PatchProduct(ctx context.Context, req *UpdateProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchProduct not implemented")
+}
+func (*UnimplementedProductServiceServer) DeleteProduct(ctx context.Context, req *GetProductId) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (*UnimplementedProductServiceServer) ListProducts(ctx context.Context, req *GetListRequest) (*GetListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (*UnimplementedProductServiceServer) IncreaseProductAmount(ctx context.Context, req *ProductAmountRequest) (*ProductAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseProductAmount not implemented")
+}
+func (*UnimplementedProductServiceServer) DecreaseProductAmount(ctx context.Context, req *ProductAmountRequest) (*ProductAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreaseProductAmount not implemented")
+}
+func (*UnimplementedProductServiceServer) CheckAmount(ctx context.Context, req *GetProductId) (*CheckAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAmount not implemented")
+}
+func (*UnimplementedProductServiceServer) BuyProduct(ctx context.Context, req *BuyProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyProduct not implemented")
+}
+func (*UnimplementedProductServiceServer) GetPurchasedProductsByUserId(ctx context.Context, req *GetUserID) (*GetPurchasedProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchasedProductsByUserId not implemented")
+}
+func (*UnimplementedProductServiceServer) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (*UnimplementedProductServiceServer) GetOrder(ctx context.Context, req *GetOrderId) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (*UnimplementedProductServiceServer) ListOrdersByUser(ctx context.Context, req *ListOrdersByUserRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrdersByUser not implemented")
+}
+func (*UnimplementedProductServiceServer) TransitionOrder(ctx context.Context, req *TransitionOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionOrder not implemented")
+}
+func (*UnimplementedProductServiceServer) ReturnOrderItem(ctx context.Context, req *ReturnOrderItemRequest) (*OrderReturn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrderItem not implemented")
+}
+func (*UnimplementedProductServiceServer) ReserveStock(ctx context.Context, req *ReserveStockRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (*UnimplementedProductServiceServer) CommitReservation(ctx context.Context, req *GetReservationId) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
+func (*UnimplementedProductServiceServer) ReleaseReservation(ctx context.Context, req *GetReservationId) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (*UnimplementedProductServiceServer) ListStockMovements(ctx context.Context, req *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+}
+func (*UnimplementedProductServiceServer) ReconcileStock(ctx context.Context, req *ReconcileStockRequest) (*ReconcileStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileStock not implemented")
+}
+func (*UnimplementedProductServiceServer) RestoreProduct(ctx context.Context, req *GetProductId) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (*UnimplementedProductServiceServer) PurgeDeletedProducts(ctx context.Context, req *PurgeDeletedProductsRequest) (*PurgeDeletedProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeletedProducts not implemented")
+}
+func (*UnimplementedProductServiceServer) SearchProducts(ctx context.Context, req *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (*UnimplementedProductServiceServer) SuggestProducts(ctx context.Context, req *SuggestProductsRequest) (*SuggestProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestProducts not implemented")
+}
+func (*UnimplementedProductServiceServer) CreateCategory(ctx context.Context, req *Category) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (*UnimplementedProductServiceServer) GetCategory(ctx context.Context, req *GetCategoryId) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (*UnimplementedProductServiceServer) UpdateCategory(ctx context.Context, req *Category) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (*UnimplementedProductServiceServer) DeleteCategory(ctx context.Context, req *GetCategoryId) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (*UnimplementedProductServiceServer) ListCategories(ctx context.Context, req *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (*UnimplementedProductServiceServer) SetProductCategories(ctx context.Context, req *ProductCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductCategories not implemented")
+}
+func (*UnimplementedProductServiceServer) GetProductCategories(ctx context.Context, req *GetProductId) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductCategories not implemented")
+}
+
+func RegisterProductServiceServer(s *grpc.Server, srv ProductServiceServer) {
+	s.RegisterService(&_ProductService_serviceDesc, srv)
+}
+
+func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Product)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/CreateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*Product))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/GetProductById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductById(ctx, req.(*GetProductId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/GetProductsByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductsByIds(ctx, req.(*GetProductsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Product)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/UpdateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*Product))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PatchProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PatchProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/PatchProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PatchProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/DeleteProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*GetProductId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/ListProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProducts(ctx, req.(*GetListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_IncreaseProductAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).IncreaseProductAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/IncreaseProductAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).IncreaseProductAmount(ctx, req.(*ProductAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DecreaseProductAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DecreaseProductAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/DecreaseProductAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DecreaseProductAmount(ctx, req.(*ProductAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CheckAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CheckAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/CheckAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CheckAmount(ctx, req.(*GetProductId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BuyProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BuyProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/BuyProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BuyProduct(ctx, req.(*BuyProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPurchasedProductsByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPurchasedProductsByUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/GetPurchasedProductsByUserId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPurchasedProductsByUserId(ctx, req.(*GetUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/CreateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetOrder(ctx, req.(*GetOrderId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListOrdersByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListOrdersByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/ListOrdersByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListOrdersByUser(ctx, req.(*ListOrdersByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_TransitionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).TransitionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/TransitionOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).TransitionOrder(ctx, req.(*TransitionOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReturnOrderItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnOrderItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReturnOrderItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/ReturnOrderItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReturnOrderItem(ctx, req.(*ReturnOrderItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/ReserveStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/CommitReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CommitReservation(ctx, req.(*GetReservationId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/ReleaseReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReleaseReservation(ctx, req.(*GetReservationId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/ListStockMovements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReconcileStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReconcileStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/ReconcileStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReconcileStock(ctx, req.(*ReconcileStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/RestoreProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, req.(*GetProductId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PurgeDeletedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeletedProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PurgeDeletedProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/PurgeDeletedProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PurgeDeletedProducts(ctx, req.(*PurgeDeletedProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/SearchProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SuggestProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SuggestProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/SuggestProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SuggestProducts(ctx, req.(*SuggestProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Category)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/CreateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateCategory(ctx, req.(*Category))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/GetCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategory(ctx, req.(*GetCategoryId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Category)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/UpdateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, req.(*Category))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/DeleteCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteCategory(ctx, req.(*GetCategoryId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/ListCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/SetProductCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductCategories(ctx, req.(*ProductCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product.ProductService/GetProductCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductCategories(ctx, req.(*GetProductId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProductService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "product.ProductService",
+	HandlerType: (*ProductServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProduct",
+			Handler:    _ProductService_CreateProduct_Handler,
+		},
+		{
+			MethodName: "GetProductById",
+			Handler:    _ProductService_GetProductById_Handler,
+		},
+		{
+			MethodName: "GetProductsByIds",
+			Handler:    _ProductService_GetProductsByIds_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "PatchProduct",
+			Handler:    _ProductService_PatchProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ProductService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _ProductService_ListProducts_Handler,
+		},
+		{
+			MethodName: "IncreaseProductAmount",
+			Handler:    _ProductService_IncreaseProductAmount_Handler,
+		},
+		{
+			MethodName: "DecreaseProductAmount",
+			Handler:    _ProductService_DecreaseProductAmount_Handler,
+		},
+		{
+			MethodName: "CheckAmount",
+			Handler:    _ProductService_CheckAmount_Handler,
+		},
+		{
+			MethodName: "BuyProduct",
+			Handler:    _ProductService_BuyProduct_Handler,
+		},
+		{
+			MethodName: "GetPurchasedProductsByUserId",
+			Handler:    _ProductService_GetPurchasedProductsByUserId_Handler,
+		},
+		{
+			MethodName: "CreateOrder",
+			Handler:    _ProductService_CreateOrder_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _ProductService_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrdersByUser",
+			Handler:    _ProductService_ListOrdersByUser_Handler,
+		},
+		{
+			MethodName: "TransitionOrder",
+			Handler:    _ProductService_TransitionOrder_Handler,
+		},
+		{
+			MethodName: "ReturnOrderItem",
+			Handler:    _ProductService_ReturnOrderItem_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _ProductService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "CommitReservation",
+			Handler:    _ProductService_CommitReservation_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _ProductService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "ListStockMovements",
+			Handler:    _ProductService_ListStockMovements_Handler,
+		},
+		{
+			MethodName: "ReconcileStock",
+			Handler:    _ProductService_ReconcileStock_Handler,
+		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _ProductService_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "PurgeDeletedProducts",
+			Handler:    _ProductService_PurgeDeletedProducts_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "SuggestProducts",
+			Handler:    _ProductService_SuggestProducts_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _ProductService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _ProductService_GetCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _ProductService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _ProductService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _ProductService_ListCategories_Handler,
+		},
+		{
+			MethodName: "SetProductCategories",
+			Handler:    _ProductService_SetProductCategories_Handler,
+		},
+		{
+			MethodName: "GetProductCategories",
+			Handler:    _ProductService_GetProductCategories_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "product-service/product.proto",
+}
+
+func (m *Product) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Product) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Version != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Deleted) > 0 {
+		i -= len(m.Deleted)
+		copy(dAtA[i:], m.Deleted)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Deleted)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Price != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Price))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateProductRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateProductRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateProductRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProduct(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Product != nil {
+		{
+			size, err := m.Product.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProduct(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProductId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProductId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProductId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeDeleted {
+		i--
+		if m.IncludeDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PriceBounds) > 0 {
+		for iNdEx := len(m.PriceBounds) - 1; iNdEx >= 0; iNdEx-- {
+			f3 := math.Float32bits(float32(m.PriceBounds[iNdEx]))
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f3))
+		}
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.PriceBounds)*4))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.IncludeFacets {
+		i--
+		if m.IncludeFacets {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Descending {
+		i--
+		if m.Descending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SortBy != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.SortBy))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CreatedBefore) > 0 {
+		i -= len(m.CreatedBefore)
+		copy(dAtA[i:], m.CreatedBefore)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedBefore)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CreatedAfter) > 0 {
+		i -= len(m.CreatedAfter)
+		copy(dAtA[i:], m.CreatedAfter)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedAfter)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.InStock {
+		i--
+		if m.InStock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MaxPrice))))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if m.MinPrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MinPrice))))
+		i--
+		dAtA[i] = 0x35
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CategoryId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.CategoryId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IncludeDeleted {
+		i--
+		if m.IncludeDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Facets != nil {
+		{
+			size, err := m.Facets.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProduct(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HasNext {
+		i--
+		if m.HasNext {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalPages != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.TotalPages))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Products) > 0 {
+		for iNdEx := len(m.Products) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Products[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Facets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Facets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Facets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OutOfStock != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.OutOfStock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InStock != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.InStock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PriceBuckets) > 0 {
+		for iNdEx := len(m.PriceBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Categories) > 0 {
+		for iNdEx := len(m.Categories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Categories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CategoryFacet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CategoryFacet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryFacet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CategoryId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.CategoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Max != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Max))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.Min != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Min))))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProductAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProductAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProductAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpectedVersion != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ExpectedVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AmountBy != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.AmountBy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProductAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProductAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProductAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Product != nil {
+		{
+			size, err := m.Product.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProduct(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IsEnough {
+		i--
+		if m.IsEnough {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reserved != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Reserved))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Amount != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveStockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveStockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveStockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TtlSeconds != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.TtlSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReservationId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReservationId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReservationId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReservationId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ReservationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyProductRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyProductRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyProductRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Amount != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UnitPrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.UnitPrice))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RefundedAt) > 0 {
+		i -= len(m.RefundedAt)
+		copy(dAtA[i:], m.RefundedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.RefundedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CancelledAt) > 0 {
+		i -= len(m.CancelledAt)
+		copy(dAtA[i:], m.CancelledAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CancelledAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.DeliveredAt) > 0 {
+		i -= len(m.DeliveredAt)
+		copy(dAtA[i:], m.DeliveredAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.DeliveredAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ShippedAt) > 0 {
+		i -= len(m.ShippedAt)
+		copy(dAtA[i:], m.ShippedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.ShippedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PaidAt) > 0 {
+		i -= len(m.PaidAt)
+		copy(dAtA[i:], m.PaidAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.PaidAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TotalQuantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.TotalQuantity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalPrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.TotalPrice))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransitionOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransitionOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransitionOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReturnOrderItemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReturnOrderItemRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReturnOrderItemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Restock {
+		i--
+		if m.Restock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Reason != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderReturn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderReturn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderReturn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RefundAmount != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RefundAmount))))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if m.Restocked {
+		i--
+		if m.Restocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Reason != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetOrderId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOrderId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOrderId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListOrdersByUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOrdersByUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOrdersByUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Page != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StockMovement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StockMovement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StockMovement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Balance != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Delta != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Delta))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListStockMovementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListStockMovementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListStockMovementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Page != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListStockMovementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListStockMovementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListStockMovementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Movements) > 0 {
+		for iNdEx := len(m.Movements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Movements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileStockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileStockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileStockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StockDiscrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StockDiscrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StockDiscrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LedgerAmount != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.LedgerAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Amount != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileStockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileStockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileStockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Discrepancies) > 0 {
+		for iNdEx := len(m.Discrepancies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Discrepancies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Checked != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Checked))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProductsByIdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProductsByIdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProductsByIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeDeleted {
+		i--
+		if m.IncludeDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProductIds) > 0 {
+		dAtA7 := make([]byte, len(m.ProductIds)*10)
+		var j6 int
+		for _, num1 := range m.ProductIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintProduct(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProductsByIdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProductsByIdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProductsByIdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MissingIds) > 0 {
+		dAtA9 := make([]byte, len(m.MissingIds)*10)
+		var j8 int
+		for _, num1 := range m.MissingIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintProduct(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Products) > 0 {
+		for iNdEx := len(m.Products) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Products[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Purchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Purchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Purchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UnitPrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.UnitPrice))))
+		i--
+		dAtA[i] = 0x35
+	}
+	if m.NetQuantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.NetQuantity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReturnedQuantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ReturnedQuantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPurchasedProductsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPurchasedProductsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPurchasedProductsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Purchases) > 0 {
+		for iNdEx := len(m.Purchases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Purchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Products) > 0 {
+		for iNdEx := len(m.Products) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Products[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeDeletedProductsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDeletedProductsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDeletedProductsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OlderThan) > 0 {
+		i -= len(m.OlderThan)
+		copy(dAtA[i:], m.OlderThan)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.OlderThan)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Category) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Category) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Category) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Position != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Slug) > 0 {
+		i -= len(m.Slug)
+		copy(dAtA[i:], m.Slug)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Slug)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ParentId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ParentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCategoryId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCategoryId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCategoryId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CategoryId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.CategoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCategoriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCategoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCategoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Recursive {
+		i--
+		if m.Recursive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParentId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ParentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCategoriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCategoriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCategoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Categories) > 0 {
+		for iNdEx := len(m.Categories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Categories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProductCategoriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProductCategoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProductCategoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CategoryIds) > 0 {
+		dAtA11 := make([]byte, len(m.CategoryIds)*10)
+		var j10 int
+		for _, num1 := range m.CategoryIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintProduct(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchProductsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchProductsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchProductsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PriceBounds) > 0 {
+		for iNdEx := len(m.PriceBounds) - 1; iNdEx >= 0; iNdEx-- {
+			f12 := math.Float32bits(float32(m.PriceBounds[iNdEx]))
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f12))
+		}
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.PriceBounds)*4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.IncludeFacets {
+		i--
+		if m.IncludeFacets {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CategoryId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.CategoryId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Limit != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Page != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchHit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchHit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchHit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DescriptionHighlight) > 0 {
+		i -= len(m.DescriptionHighlight)
+		copy(dAtA[i:], m.DescriptionHighlight)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.DescriptionHighlight)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NameHighlight) > 0 {
+		i -= len(m.NameHighlight)
+		copy(dAtA[i:], m.NameHighlight)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.NameHighlight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Score != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Score))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.Product != nil {
+		{
+			size, err := m.Product.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProduct(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchProductsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchProductsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchProductsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Facets != nil {
+		{
+			size, err := m.Facets.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProduct(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HasNext {
+		i--
+		if m.HasNext {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalPages != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.TotalPages))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hits) > 0 {
+		for iNdEx := len(m.Hits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuggestProductsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuggestProductsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuggestProductsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InStock {
+		i--
+		if m.InStock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Suggestion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Suggestion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Suggestion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuggestProductsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuggestProductsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuggestProductsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Suggestions) > 0 {
+		for iNdEx := len(m.Suggestions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Suggestions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProduct(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeDeletedProductsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDeletedProductsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDeletedProductsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Purged != 0 {
+		i = encodeVarintProduct(dAtA, i, uint64(m.Purged))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProduct(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProduct(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Product) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProduct(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 5
+	}
+	if m.Amount != 0 {
+		n += 1 + sovProduct(uint64(m.Amount))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.Deleted)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovProduct(uint64(m.Version))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateProductRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Product != nil {
+		l = m.Product.Size()
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetProductId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.IncludeDeleted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovProduct(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovProduct(uint64(m.Limit))
+	}
+	if m.IncludeDeleted {
+		n += 2
+	}
+	if m.CategoryId != 0 {
+		n += 1 + sovProduct(uint64(m.CategoryId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.MinPrice != 0 {
+		n += 5
+	}
+	if m.MaxPrice != 0 {
+		n += 5
+	}
+	if m.InStock {
+		n += 2
+	}
+	l = len(m.CreatedAfter)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.CreatedBefore)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.SortBy != 0 {
+		n += 1 + sovProduct(uint64(m.SortBy))
+	}
+	if m.Descending {
+		n += 2
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.IncludeFacets {
+		n += 2
+	}
+	if len(m.PriceBounds) > 0 {
+		n += 1 + sovProduct(uint64(len(m.PriceBounds)*4)) + len(m.PriceBounds)*4
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovProduct(uint64(m.Count))
+	}
+	if len(m.Products) > 0 {
+		for _, e := range m.Products {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.TotalCount != 0 {
+		n += 1 + sovProduct(uint64(m.TotalCount))
+	}
+	if m.TotalPages != 0 {
+		n += 1 + sovProduct(uint64(m.TotalPages))
+	}
+	if m.HasNext {
+		n += 2
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Facets != nil {
+		l = m.Facets.Size()
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Facets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Categories) > 0 {
+		for _, e := range m.Categories {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if len(m.PriceBuckets) > 0 {
+		for _, e := range m.PriceBuckets {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.InStock != 0 {
+		n += 1 + sovProduct(uint64(m.InStock))
+	}
+	if m.OutOfStock != 0 {
+		n += 1 + sovProduct(uint64(m.OutOfStock))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CategoryFacet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CategoryId != 0 {
+		n += 1 + sovProduct(uint64(m.CategoryId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovProduct(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PriceBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != 0 {
+		n += 5
+	}
+	if m.Max != 0 {
+		n += 5
+	}
+	if m.Count != 0 {
+		n += 1 + sovProduct(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProductAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.AmountBy != 0 {
+		n += 1 + sovProduct(uint64(m.AmountBy))
+	}
+	if m.ExpectedVersion != 0 {
+		n += 1 + sovProduct(uint64(m.ExpectedVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProductAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsEnough {
+		n += 2
+	}
+	if m.Product != nil {
+		l = m.Product.Size()
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovProduct(uint64(m.Amount))
+	}
+	if m.Reserved != 0 {
+		n += 1 + sovProduct(uint64(m.Reserved))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReserveStockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovProduct(uint64(m.Quantity))
+	}
+	if m.TtlSeconds != 0 {
+		n += 1 + sovProduct(uint64(m.TtlSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Reservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProduct(uint64(m.Id))
+	}
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovProduct(uint64(m.Quantity))
+	}
+	if m.Status != 0 {
+		n += 1 + sovProduct(uint64(m.Status))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReservationId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReservationId != 0 {
+		n += 1 + sovProduct(uint64(m.ReservationId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BuyProductRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovProduct(uint64(m.Amount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrderLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovProduct(uint64(m.Quantity))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrderItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovProduct(uint64(m.Quantity))
+	}
+	if m.UnitPrice != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProduct(uint64(m.Id))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.TotalPrice != 0 {
+		n += 5
+	}
+	if m.TotalQuantity != 0 {
+		n += 1 + sovProduct(uint64(m.TotalQuantity))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovProduct(uint64(m.Status))
+	}
+	l = len(m.PaidAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.ShippedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.DeliveredAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.CancelledAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.RefundedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransitionOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovProduct(uint64(m.OrderId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovProduct(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReturnOrderItemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovProduct(uint64(m.OrderId))
+	}
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovProduct(uint64(m.Quantity))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovProduct(uint64(m.Reason))
+	}
+	if m.Restock {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrderReturn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProduct(uint64(m.Id))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovProduct(uint64(m.OrderId))
+	}
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovProduct(uint64(m.Quantity))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovProduct(uint64(m.Reason))
+	}
+	if m.Restocked {
+		n += 2
+	}
+	if m.RefundAmount != 0 {
+		n += 5
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOrderId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovProduct(uint64(m.OrderId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListOrdersByUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovProduct(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovProduct(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.TotalCount != 0 {
+		n += 1 + sovProduct(uint64(m.TotalCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StockMovement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProduct(uint64(m.Id))
+	}
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Delta != 0 {
+		n += 1 + sovProduct(uint64(m.Delta))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovProduct(uint64(m.Reason))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovProduct(uint64(m.Balance))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListStockMovementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovProduct(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovProduct(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListStockMovementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Movements) > 0 {
+		for _, e := range m.Movements {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.TotalCount != 0 {
+		n += 1 + sovProduct(uint64(m.TotalCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReconcileStockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StockDiscrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovProduct(uint64(m.Amount))
+	}
+	if m.LedgerAmount != 0 {
+		n += 1 + sovProduct(uint64(m.LedgerAmount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReconcileStockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checked != 0 {
+		n += 1 + sovProduct(uint64(m.Checked))
+	}
+	if len(m.Discrepancies) > 0 {
+		for _, e := range m.Discrepancies {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetProductsByIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProductIds) > 0 {
+		l = 0
+		for _, e := range m.ProductIds {
+			l += sovProduct(uint64(e))
+		}
+		n += 1 + sovProduct(uint64(l)) + l
+	}
+	if m.IncludeDeleted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetProductsByIdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Products) > 0 {
+		for _, e := range m.Products {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if len(m.MissingIds) > 0 {
+		l = 0
+		for _, e := range m.MissingIds {
+			l += sovProduct(uint64(e))
+		}
+		n += 1 + sovProduct(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetUserID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Purchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovProduct(uint64(m.OrderId))
+	}
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovProduct(uint64(m.Quantity))
+	}
+	if m.ReturnedQuantity != 0 {
+		n += 1 + sovProduct(uint64(m.ReturnedQuantity))
+	}
+	if m.NetQuantity != 0 {
+		n += 1 + sovProduct(uint64(m.NetQuantity))
+	}
+	if m.UnitPrice != 0 {
+		n += 5
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPurchasedProductsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Products) > 0 {
+		for _, e := range m.Products {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if len(m.Purchases) > 0 {
+		for _, e := range m.Purchases {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeDeletedProductsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OlderThan)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Category) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProduct(uint64(m.Id))
+	}
+	if m.ParentId != 0 {
+		n += 1 + sovProduct(uint64(m.ParentId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.Slug)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovProduct(uint64(m.Position))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCategoryId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CategoryId != 0 {
+		n += 1 + sovProduct(uint64(m.CategoryId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCategoriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentId != 0 {
+		n += 1 + sovProduct(uint64(m.ParentId))
+	}
+	if m.Recursive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCategoriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Categories) > 0 {
+		for _, e := range m.Categories {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProductCategoriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	if len(m.CategoryIds) > 0 {
+		l = 0
+		for _, e := range m.CategoryIds {
+			l += sovProduct(uint64(e))
+		}
+		n += 1 + sovProduct(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchProductsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovProduct(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovProduct(uint64(m.Limit))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.CategoryId != 0 {
+		n += 1 + sovProduct(uint64(m.CategoryId))
+	}
+	if m.IncludeFacets {
+		n += 2
+	}
+	if len(m.PriceBounds) > 0 {
+		n += 1 + sovProduct(uint64(len(m.PriceBounds)*4)) + len(m.PriceBounds)*4
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchHit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Product != nil {
+		l = m.Product.Size()
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 5
+	}
+	l = len(m.NameHighlight)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	l = len(m.DescriptionHighlight)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchProductsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovProduct(uint64(m.Count))
+	}
+	if len(m.Hits) > 0 {
+		for _, e := range m.Hits {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.TotalCount != 0 {
+		n += 1 + sovProduct(uint64(m.TotalCount))
+	}
+	if m.TotalPages != 0 {
+		n += 1 + sovProduct(uint64(m.TotalPages))
+	}
+	if m.HasNext {
+		n += 2
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Facets != nil {
+		l = m.Facets.Size()
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SuggestProductsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovProduct(uint64(m.Limit))
+	}
+	if m.InStock {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Suggestion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProductId != 0 {
+		n += 1 + sovProduct(uint64(m.ProductId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SuggestProductsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Suggestions) > 0 {
+		for _, e := range m.Suggestions {
+			l = e.Size()
+			n += 1 + l + sovProduct(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeDeletedProductsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Purged != 0 {
+		n += 1 + sovProduct(uint64(m.Purged))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovProduct(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProduct(x uint64) (n int) {
+	return sovProduct(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Product) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Product: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Product: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Price = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deleted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateProductRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateProductRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateProductRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Product", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Product == nil {
+				m.Product = &Product{}
+			}
+			if err := m.Product.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProductId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProductId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProductId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeDeleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeDeleted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			m.CategoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.MinPrice = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.MaxPrice = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InStock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InStock = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBefore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
+			}
+			m.SortBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortBy |= SortField(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Descending = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeFacets", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeFacets = bool(v != 0)
+		case 15:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.PriceBounds = append(m.PriceBounds, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProduct
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProduct
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProduct
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PriceBounds) == 0 {
+					m.PriceBounds = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.PriceBounds = append(m.PriceBounds, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBounds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Products", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Products = append(m.Products, &Product{})
+			if err := m.Products[len(m.Products)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			m.TotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			}
+			m.TotalPages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPages |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasNext", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasNext = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Facets == nil {
+				m.Facets = &Facets{}
+			}
+			if err := m.Facets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Facets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Categories = append(m.Categories, &CategoryFacet{})
+			if err := m.Categories[len(m.Categories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceBuckets = append(m.PriceBuckets, &PriceBucket{})
+			if err := m.PriceBuckets[len(m.PriceBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InStock", wireType)
+			}
+			m.InStock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InStock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfStock", wireType)
+			}
+			m.OutOfStock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutOfStock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CategoryFacet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CategoryFacet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CategoryFacet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			m.CategoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Min = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Max = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProductAmountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProductAmountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProductAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountBy", wireType)
+			}
+			m.AmountBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountBy |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedVersion", wireType)
+			}
+			m.ExpectedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProductAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProductAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProductAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEnough", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEnough = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Product", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Product == nil {
+				m.Product = &Product{}
+			}
+			if err := m.Product.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			m.Reserved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reserved |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveStockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveStockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveStockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
+			}
+			m.TtlSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TtlSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ReservationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReservationId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReservationId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReservationId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservationId", wireType)
+			}
+			m.ReservationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservationId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyProductRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyProductRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyProductRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &OrderLine{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.UnitPrice = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &OrderItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrice", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.TotalPrice = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalQuantity", wireType)
+			}
+			m.TotalQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalQuantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShippedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShippedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveredAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransitionOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransitionOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransitionOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReturnOrderItemRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReturnOrderItemRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReturnOrderItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ReturnReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restock = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderReturn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderReturn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderReturn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ReturnReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restocked = bool(v != 0)
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.RefundAmount = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetOrderId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOrderId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOrderId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListOrdersByUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListOrdersByUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListOrdersByUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			m.TotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StockMovement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StockMovement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StockMovement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			m.Delta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delta |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= StockMovementReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListStockMovementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListStockMovementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListStockMovementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListStockMovementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListStockMovementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListStockMovementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Movements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Movements = append(m.Movements, &StockMovement{})
+			if err := m.Movements[len(m.Movements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			m.TotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconcileStockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconcileStockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconcileStockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StockDiscrepancy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StockDiscrepancy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StockDiscrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerAmount", wireType)
+			}
+			m.LedgerAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LedgerAmount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconcileStockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconcileStockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconcileStockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checked", wireType)
+			}
+			m.Checked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checked |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discrepancies = append(m.Discrepancies, &StockDiscrepancy{})
+			if err := m.Discrepancies[len(m.Discrepancies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProductsByIdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProductsByIdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProductsByIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProduct
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProductIds = append(m.ProductIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProduct
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProduct
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProduct
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProductIds) == 0 {
+					m.ProductIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProduct
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProductIds = append(m.ProductIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductIds", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeDeleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProductsByIdsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProductsByIdsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProductsByIdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Products", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Products = append(m.Products, &Product{})
+			if err := m.Products[len(m.Products)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProduct
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingIds = append(m.MissingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProduct
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProduct
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProduct
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissingIds) == 0 {
+					m.MissingIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProduct
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingIds = append(m.MissingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUserID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUserID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUserID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Purchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Purchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Purchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnedQuantity", wireType)
+			}
+			m.ReturnedQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReturnedQuantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetQuantity", wireType)
+			}
+			m.NetQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetQuantity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.UnitPrice = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *BuyProductRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovProduct(uint64(l))
-	}
-	if m.ProductId != 0 {
-		n += 1 + sovProduct(uint64(m.ProductId))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovProduct(uint64(m.Amount))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *GetUserID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovProduct(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetPurchasedProductsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPurchasedProductsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPurchasedProductsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Products", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Products = append(m.Products, &Product{})
+			if err := m.Products[len(m.Products)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchases = append(m.Purchases, &Purchase{})
+			if err := m.Purchases[len(m.Purchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *GetPurchasedProductsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Products) > 0 {
-		for _, e := range m.Products {
-			l = e.Size()
-			n += 1 + l + sovProduct(uint64(l))
+	return nil
+}
+func (m *PurgeDeletedProductsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDeletedProductsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDeletedProductsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OlderThan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OlderThan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovProduct(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProduct(x uint64) (n int) {
-	return sovProduct(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Product) Unmarshal(dAtA []byte) error {
+func (m *Category) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,10 +14500,10 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Product: wiretype end group for non-group")
+			return fmt.Errorf("proto: Category: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Product: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Category: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1880,6 +14526,25 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			m.ParentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -1911,9 +14576,9 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slug", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1941,24 +14606,13 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Slug = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Price = float32(math.Float32frombits(v))
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			m.Amount = 0
+			m.Position = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -1968,7 +14622,7 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int32(b&0x7F) << shift
+				m.Position |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2037,11 +14691,222 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 			}
 			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCategoryId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCategoryId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCategoryId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			m.CategoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCategoriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCategoriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCategoriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			m.ParentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recursive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProduct(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCategoriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProduct
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCategoriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCategoriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2051,23 +14916,25 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProduct
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProduct
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deleted = string(dAtA[iNdEx:postIndex])
+			m.Categories = append(m.Categories, &Category{})
+			if err := m.Categories[len(m.Categories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2091,7 +14958,7 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetProductId) Unmarshal(dAtA []byte) error {
+func (m *ProductCategoriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2114,10 +14981,10 @@ func (m *GetProductId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetProductId: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProductCategoriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetProductId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProductCategoriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2139,6 +15006,82 @@ func (m *GetProductId) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProduct
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CategoryIds = append(m.CategoryIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProduct
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProduct
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProduct
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CategoryIds) == 0 {
+					m.CategoryIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProduct
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CategoryIds = append(m.CategoryIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProduct(dAtA[iNdEx:])
@@ -2161,7 +15104,7 @@ func (m *GetProductId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetListRequest) Unmarshal(dAtA []byte) error {
+func (m *SearchProductsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2184,13 +15127,45 @@ func (m *GetListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchProductsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchProductsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
@@ -2209,7 +15184,7 @@ func (m *GetListRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
@@ -2228,6 +15203,131 @@ func (m *GetListRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			m.CategoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeFacets", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeFacets = bool(v != 0)
+		case 7:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.PriceBounds = append(m.PriceBounds, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProduct
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProduct
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProduct
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PriceBounds) == 0 {
+					m.PriceBounds = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.PriceBounds = append(m.PriceBounds, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBounds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProduct(dAtA[iNdEx:])
@@ -2250,7 +15350,7 @@ func (m *GetListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetListResponse) Unmarshal(dAtA []byte) error {
+func (m *SearchHit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2273,17 +15373,17 @@ func (m *GetListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchHit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchHit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Product", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2293,16 +15393,44 @@ func (m *GetListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Product == nil {
+				m.Product = &Product{}
+			}
+			if err := m.Product.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Score = float32(math.Float32frombits(v))
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Products", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NameHighlight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2312,25 +15440,55 @@ func (m *GetListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProduct
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProduct
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Products = append(m.Products, &Product{})
-			if err := m.Products[len(m.Products)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NameHighlight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionHighlight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.DescriptionHighlight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2354,7 +15512,7 @@ func (m *GetListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Status) Unmarshal(dAtA []byte) error {
+func (m *SearchProductsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2377,17 +15535,17 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchProductsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchProductsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2397,68 +15555,50 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProduct(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProduct
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthProduct
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProductAmountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProduct
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Hits = append(m.Hits, &SearchHit{})
+			if err := m.Hits[len(m.Hits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProductAmountRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProductAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
 			}
-			m.ProductId = 0
+			m.TotalCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2468,16 +15608,16 @@ func (m *ProductAmountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProductId |= int32(b&0x7F) << shift
+				m.TotalCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
 			}
-			m.AmountBy = 0
+			m.TotalPages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2487,65 +15627,14 @@ func (m *ProductAmountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AmountBy |= int32(b&0x7F) << shift
+				m.TotalPages |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProduct(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProduct
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProductAmountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProduct
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProductAmountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProductAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEnough", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasNext", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2562,10 +15651,42 @@ func (m *ProductAmountResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsEnough = bool(v != 0)
-		case 2:
+			m.HasNext = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Product", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2592,10 +15713,10 @@ func (m *ProductAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Product == nil {
-				m.Product = &Product{}
+			if m.Facets == nil {
+				m.Facets = &Facets{}
 			}
-			if err := m.Product.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Facets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2621,7 +15742,7 @@ func (m *ProductAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckAmountResponse) Unmarshal(dAtA []byte) error {
+func (m *SuggestProductsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2644,17 +15765,17 @@ func (m *CheckAmountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckAmountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuggestProductsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuggestProductsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			m.ProductId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2664,16 +15785,29 @@ func (m *CheckAmountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProductId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Amount = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2683,11 +15817,31 @@ func (m *CheckAmountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int32(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InStock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.InStock = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProduct(dAtA[iNdEx:])
@@ -2710,7 +15864,7 @@ func (m *CheckAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuyProductRequest) Unmarshal(dAtA []byte) error {
+func (m *Suggestion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2733,45 +15887,13 @@ func (m *BuyProductRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuyProductRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Suggestion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuyProductRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Suggestion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProduct
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProduct
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProduct
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
 			}
@@ -2790,11 +15912,11 @@ func (m *BuyProductRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2804,11 +15926,24 @@ func (m *BuyProductRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProduct
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProduct(dAtA[iNdEx:])
@@ -2831,7 +15966,7 @@ func (m *BuyProductRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUserID) Unmarshal(dAtA []byte) error {
+func (m *SuggestProductsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2854,17 +15989,17 @@ func (m *GetUserID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUserID: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuggestProductsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUserID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuggestProductsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suggestions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2874,23 +16009,25 @@ func (m *GetUserID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProduct
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProduct
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
+			m.Suggestions = append(m.Suggestions, &Suggestion{})
+			if err := m.Suggestions[len(m.Suggestions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2914,7 +16051,7 @@ func (m *GetUserID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPurchasedProductsResponse) Unmarshal(dAtA []byte) error {
+func (m *PurgeDeletedProductsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2937,17 +16074,17 @@ func (m *GetPurchasedProductsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPurchasedProductsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDeletedProductsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPurchasedProductsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDeletedProductsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Products", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purged", wireType)
 			}
-			var msglen int
+			m.Purged = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProduct
@@ -2957,26 +16094,11 @@ func (m *GetPurchasedProductsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Purged |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProduct
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProduct
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Products = append(m.Products, &Product{})
-			if err := m.Products[len(m.Products)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProduct(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 CREATE TABLE IF NOT EXISTS users_products (
     id SERIAL PRIMARY KEY,
-    user_id UUID NOT NULL ON DELETE CASCADE,
-    product_id INT NOT NULL ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    product_id INT NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
     amount INT NOT NULL DEFAULT 1
 );
+
+CREATE INDEX IF NOT EXISTS users_products_user_id_idx ON users_products (user_id);
@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS products_price_id ON products (price, id);
+CREATE INDEX IF NOT EXISTS products_name_id ON products (name, id);
+CREATE INDEX IF NOT EXISTS products_created_at_id ON products (created_at, id);
+CREATE INDEX IF NOT EXISTS products_amount_id ON products (amount, id);
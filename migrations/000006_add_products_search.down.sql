@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS products_name_trgm;
+DROP INDEX IF EXISTS products_search;
+ALTER TABLE products DROP COLUMN IF EXISTS search;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS products_name_prefix ON products ((lower(name) COLLATE "C"), id) WHERE deleted_at IS NULL;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS users_products_order_product_idx;
+
+ALTER TABLE users_products
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS unit_price,
+    DROP COLUMN IF EXISTS order_id;
+
+DROP TABLE IF EXISTS orders;
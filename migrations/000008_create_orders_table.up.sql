@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS orders (
+    id SERIAL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS orders_user_id_idx ON orders (user_id, id);
+
+-- users_products rows become the line items of an order
+ALTER TABLE users_products
+    ADD COLUMN IF NOT EXISTS order_id INT REFERENCES orders(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS unit_price FLOAT,
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+-- every earlier purchase becomes an order of its own, priced at today's price
+DO $$
+DECLARE
+    purchase RECORD;
+    new_order_id INT;
+BEGIN
+    FOR purchase IN
+        SELECT up.id, up.user_id, p.price
+        FROM users_products up JOIN products p ON p.id = up.product_id
+        WHERE up.order_id IS NULL
+        ORDER BY up.id
+    LOOP
+        INSERT INTO orders (user_id) VALUES (purchase.user_id) RETURNING id INTO new_order_id;
+        UPDATE users_products SET order_id = new_order_id, unit_price = purchase.price WHERE id = purchase.id;
+    END LOOP;
+END $$;
+
+ALTER TABLE users_products
+    ALTER COLUMN order_id SET NOT NULL,
+    ALTER COLUMN unit_price SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_products_order_product_idx ON users_products (order_id, product_id);
@@ -0,0 +1,7 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS refunded_at,
+    DROP COLUMN IF EXISTS cancelled_at,
+    DROP COLUMN IF EXISTS delivered_at,
+    DROP COLUMN IF EXISTS shipped_at,
+    DROP COLUMN IF EXISTS paid_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,14 @@
+-- status holds the OrderStatus enum value; 0 is pending
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS status SMALLINT NOT NULL DEFAULT 0 CHECK (status BETWEEN 0 AND 5),
+    ADD COLUMN IF NOT EXISTS paid_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS shipped_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS refunded_at TIMESTAMP;
+
+-- orders backfilled from earlier purchases were completed long ago; leaving
+-- them pending would let a cancel restock units sold before orders existed
+UPDATE orders
+SET status = 3, paid_at = created_at, shipped_at = created_at, delivered_at = created_at
+WHERE status = 0;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS order_returns;
+
+ALTER TABLE users_products
+    DROP CONSTRAINT IF EXISTS users_products_returned_check,
+    DROP COLUMN IF EXISTS returned;
@@ -0,0 +1,20 @@
+-- returned counts the units of an order line sent back so far
+ALTER TABLE users_products
+    ADD COLUMN IF NOT EXISTS returned INT NOT NULL DEFAULT 0;
+
+ALTER TABLE users_products
+    ADD CONSTRAINT users_products_returned_check CHECK (returned BETWEEN 0 AND amount);
+
+-- reason holds the ReturnReason enum value
+CREATE TABLE IF NOT EXISTS order_returns (
+    id SERIAL PRIMARY KEY,
+    order_id INT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    product_id INT NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    reason SMALLINT NOT NULL DEFAULT 0,
+    restocked BOOLEAN NOT NULL DEFAULT FALSE,
+    refund_amount FLOAT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS order_returns_order_id_idx ON order_returns (order_id, product_id);
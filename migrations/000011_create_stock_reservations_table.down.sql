@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS stock_reservations;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS reserved;
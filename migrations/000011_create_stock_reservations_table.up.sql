@@ -0,0 +1,16 @@
+-- reserved is the quantity active reservations hold; amount - reserved is available
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS reserved INT NOT NULL DEFAULT 0 CHECK (reserved >= 0);
+
+-- status holds the ReservationStatus enum value; 0 is active
+CREATE TABLE IF NOT EXISTS stock_reservations (
+    id SERIAL PRIMARY KEY,
+    product_id INT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    status SMALLINT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- the sweeper only ever looks at active reservations
+CREATE INDEX IF NOT EXISTS stock_reservations_active_expiry_idx ON stock_reservations (expires_at) WHERE status = 0;
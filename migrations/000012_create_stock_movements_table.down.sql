@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS stock_movements;
+
+DROP FUNCTION IF EXISTS stock_movements_immutable();
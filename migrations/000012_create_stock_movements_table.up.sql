@@ -0,0 +1,30 @@
+-- reason holds the StockMovementReason enum value; 0 is opening. product_id
+-- has no foreign key, so the history outlives a purged product.
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id BIGSERIAL PRIMARY KEY,
+    product_id INT NOT NULL,
+    delta INT NOT NULL,
+    reason SMALLINT NOT NULL,
+    actor TEXT NOT NULL,
+    reference TEXT NOT NULL DEFAULT '',
+    balance INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS stock_movements_product_idx ON stock_movements (product_id, created_at, id);
+
+-- entries are never rewritten or removed
+CREATE OR REPLACE FUNCTION stock_movements_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'stock_movements entries are immutable';
+END $$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS stock_movements_immutable ON stock_movements;
+CREATE TRIGGER stock_movements_immutable BEFORE UPDATE OR DELETE ON stock_movements
+    FOR EACH ROW EXECUTE FUNCTION stock_movements_immutable();
+
+-- the ledger starts from the current amounts
+INSERT INTO stock_movements (product_id, delta, reason, actor, balance)
+SELECT id, amount, 0, 'migration', amount
+FROM products
+WHERE amount <> 0;
@@ -0,0 +1,9 @@
+// Package migrations embeds the Postgres schema history into the binary
+package migrations
+
+import "embed"
+
+// FS holds the golang-migrate style NNNNNN_name.{up,down}.sql files
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,30 @@
+// Package actor carries the identity of whoever caused a change from the
+// edge of the service down to the storage layer, which records it.
+package actor
+
+import "context"
+
+const (
+	// Header is the gRPC metadata key callers name themselves in
+	Header = "x-actor"
+	// System stands in for changes nobody asked for, e.g. expired reservations
+	System = "system"
+	// MaxLength caps the recorded name
+	MaxLength = 255
+)
+
+type contextKey struct{}
+
+// With returns a copy of ctx carrying name
+func With(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, contextKey{}, name)
+}
+
+// From returns the actor carried by ctx, or System
+func From(ctx context.Context) string {
+	if name, ok := ctx.Value(contextKey{}).(string); ok && name != "" {
+		return name
+	}
+
+	return System
+}
@@ -0,0 +1,20 @@
+package db
+
+import (
+	"context"
+	"exam/product-service/config"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NewMongo connects to MongoDB and returns the configured database
+func NewMongo(cfg config.Config) (*mongo.Database, error) {
+	clientOptions := options.Client().ApplyURI(cfg.MongoURI)
+	client, err := mongo.Connect(context.Background(), clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Database(cfg.MongoDatabase), nil
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"exam/product-service/config"
 	"fmt"
@@ -37,3 +38,19 @@ func New(cfg config.Config) (*Postgres, error) {
 func (p *Postgres) Close() {
 	p.DB.Close()
 }
+
+// WithTx runs fn inside a transaction, committing when it returns nil and
+// rolling back otherwise.
+func (p *Postgres) WithTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := p.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,219 @@
+// Package errs holds the domain errors shared by every storage backend and
+// their mapping onto gRPC status codes.
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// Domain is reported in google.rpc.ErrorInfo details
+const Domain = "product-service"
+
+// Kind classifies a domain error independently of the backend that raised it
+type Kind uint8
+
+const (
+	KindUnknown Kind = iota
+	KindNotFound
+	KindInsufficientStock
+	KindInvalidArgument
+	KindConflict
+	KindAlreadyExists
+	KindFailedPrecondition
+)
+
+// Code returns the gRPC status code clients see for the kind
+func (k Kind) Code() codes.Code {
+	switch k {
+	case KindNotFound:
+		return codes.NotFound
+	case KindInsufficientStock:
+		return codes.FailedPrecondition
+	case KindInvalidArgument:
+		return codes.InvalidArgument
+	case KindConflict:
+		return codes.Aborted
+	case KindAlreadyExists:
+		return codes.AlreadyExists
+	case KindFailedPrecondition:
+		return codes.FailedPrecondition
+	default:
+		return codes.Unknown
+	}
+}
+
+// Sentinels to match a kind with errors.Is
+var (
+	ErrNotFound           = &Error{Kind: KindNotFound}
+	ErrInsufficientStock  = &Error{Kind: KindInsufficientStock}
+	ErrInvalidArgument    = &Error{Kind: KindInvalidArgument}
+	ErrConflict           = &Error{Kind: KindConflict}
+	ErrAlreadyExists      = &Error{Kind: KindAlreadyExists}
+	ErrFailedPrecondition = &Error{Kind: KindFailedPrecondition}
+)
+
+// Error is a domain error. Reason and Metadata travel to clients as a
+// google.rpc.ErrorInfo detail, so they can switch on them; Violations
+// travel as a google.rpc.BadRequest detail.
+type Error struct {
+	Kind       Kind
+	Reason     string
+	Message    string
+	Metadata   map[string]string
+	Violations []FieldViolation
+}
+
+// FieldViolation names a request field and what is wrong with it
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is matches any error of the same kind
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Kind == e.Kind
+}
+
+// GRPCStatus lets grpc-go and status.Convert understand the error
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.Kind.Code(), e.Message)
+	if e.Reason == "" {
+		return st
+	}
+
+	details := []protoiface.MessageV1{&errdetails.ErrorInfo{
+		Reason:   e.Reason,
+		Domain:   Domain,
+		Metadata: e.Metadata,
+	}}
+	if len(e.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range e.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+
+	return detailed
+}
+
+// KindOf returns the kind of the first domain error in err's chain
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+
+	return KindUnknown
+}
+
+// NotFound reports a missing entity, e.g. NotFound("product", 42)
+func NotFound(entity string, id interface{}) *Error {
+	return &Error{
+		Kind:     KindNotFound,
+		Reason:   strings.ToUpper(entity) + "_NOT_FOUND",
+		Message:  fmt.Sprintf("%s %v not found", entity, id),
+		Metadata: map[string]string{entity + "_id": fmt.Sprint(id)},
+	}
+}
+
+// InsufficientStock reports that a product cannot cover the requested amount
+func InsufficientStock(productId, available, requested int32) *Error {
+	return &Error{
+		Kind:    KindInsufficientStock,
+		Reason:  "INSUFFICIENT_STOCK",
+		Message: fmt.Sprintf("product %d has %d in stock, %d requested", productId, available, requested),
+		Metadata: map[string]string{
+			"product_id": fmt.Sprint(productId),
+			"available":  fmt.Sprint(available),
+			"requested":  fmt.Sprint(requested),
+		},
+	}
+}
+
+// InvalidArgument reports a request the service refuses to process
+func InvalidArgument(format string, args ...interface{}) *Error {
+	return &Error{
+		Kind:    KindInvalidArgument,
+		Reason:  "INVALID_ARGUMENT",
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// InvalidFields reports every field that failed validation at once
+func InvalidFields(violations ...FieldViolation) *Error {
+	fields := make([]string, 0, len(violations))
+	for _, v := range violations {
+		fields = append(fields, v.Field+": "+v.Description)
+	}
+
+	return &Error{
+		Kind:       KindInvalidArgument,
+		Reason:     "INVALID_ARGUMENT",
+		Message:    "invalid request: " + strings.Join(fields, "; "),
+		Violations: violations,
+	}
+}
+
+// Conflict reports a write that lost a race with another writer
+func Conflict(format string, args ...interface{}) *Error {
+	return &Error{
+		Kind:    KindConflict,
+		Reason:  "CONFLICT",
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// VersionMismatch reports a write guarded by a version the entity has moved past
+func VersionMismatch(entity string, id interface{}, expected, current int64) *Error {
+	return &Error{
+		Kind:    KindConflict,
+		Reason:  "VERSION_MISMATCH",
+		Message: fmt.Sprintf("%s %v was modified: expected version %d, current version %d", entity, id, expected, current),
+		Metadata: map[string]string{
+			entity + "_id":     fmt.Sprint(id),
+			"expected_version": fmt.Sprint(expected),
+			"current_version":  fmt.Sprint(current),
+		},
+	}
+}
+
+// AlreadyExists reports an entity that collides with an existing one
+func AlreadyExists(entity string, id interface{}) *Error {
+	return &Error{
+		Kind:     KindAlreadyExists,
+		Reason:   strings.ToUpper(entity) + "_ALREADY_EXISTS",
+		Message:  fmt.Sprintf("%s %v already exists", entity, id),
+		Metadata: map[string]string{entity + "_id": fmt.Sprint(id)},
+	}
+}
+
+// FailedPrecondition reports a request the current state of the system
+// does not allow, e.g. deleting a category that still has subcategories
+func FailedPrecondition(reason string, format string, args ...interface{}) *Error {
+	return &Error{
+		Kind:    KindFailedPrecondition,
+		Reason:  reason,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
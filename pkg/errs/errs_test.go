@@ -0,0 +1,51 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestKindMatching(t *testing.T) {
+	err := fmt.Errorf("buy: %w", InsufficientStock(7, 1, 3))
+
+	assert.ErrorIs(t, err, ErrInsufficientStock)
+	assert.NotErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, KindInsufficientStock, KindOf(err))
+	assert.Equal(t, KindUnknown, KindOf(errors.New("boom")))
+}
+
+func TestGRPCStatus(t *testing.T) {
+	cases := map[*Error]codes.Code{
+		NotFound("product", 7):                     codes.NotFound,
+		InsufficientStock(7, 1, 3):                 codes.FailedPrecondition,
+		InvalidArgument("bad"):                     codes.InvalidArgument,
+		Conflict("stale"):                          codes.Aborted,
+		AlreadyExists("product", "abc"):            codes.AlreadyExists,
+		FailedPrecondition("HAS_CHILDREN", "busy"): codes.FailedPrecondition,
+	}
+
+	for err, code := range cases {
+		assert.Equal(t, code, status.Code(err), err.Error())
+	}
+}
+
+func TestErrorInfo(t *testing.T) {
+	st := status.Convert(InsufficientStock(7, 1, 3))
+
+	details := st.Details()
+	if assert.Len(t, details, 1) {
+		info, ok := details[0].(*errdetails.ErrorInfo)
+		if assert.True(t, ok) {
+			assert.Equal(t, "INSUFFICIENT_STOCK", info.Reason)
+			assert.Equal(t, Domain, info.Domain)
+			assert.Equal(t, "7", info.Metadata["product_id"])
+			assert.Equal(t, "1", info.Metadata["available"])
+		}
+	}
+}
@@ -0,0 +1,217 @@
+// Package migrate applies versioned schema migrations. The bookkeeping
+// follows golang-migrate: a database records the single version it is at
+// and whether the last step failed halfway (dirty).
+package migrate
+
+import (
+	"context"
+	"errors"
+	"exam/product-service/pkg/logger"
+	"fmt"
+	"sort"
+)
+
+// ErrDirty means a previous step failed halfway. Fix the schema by hand,
+// then Force the version it is really at.
+var ErrDirty = errors.New("database is dirty")
+
+// Migration identifies one step of a schema history
+type Migration struct {
+	Version uint
+	Name    string
+}
+
+// Driver runs migrations against one kind of database
+type Driver interface {
+	// Migrations lists the known migrations in ascending version order
+	Migrations() []Migration
+	// Lock keeps other migrators out until unlock is called
+	Lock(ctx context.Context) (unlock func(), err error)
+	// Version returns the recorded version, 0 when nothing is applied
+	Version(ctx context.Context) (version uint, dirty bool, err error)
+	// Up applies m and records m.Version
+	Up(ctx context.Context, m Migration) error
+	// Down reverts m and records previous
+	Down(ctx context.Context, m Migration, previous uint) error
+	// Force records version without running anything and clears dirty
+	Force(ctx context.Context, version uint) error
+}
+
+// Status is the state of a database against the known migrations
+type Status struct {
+	Version    uint
+	Dirty      bool
+	Migrations []MigrationStatus
+}
+
+type MigrationStatus struct {
+	Migration
+	Applied bool
+}
+
+type Migrator struct {
+	driver Driver
+	log    logger.Logger
+}
+
+// Constructor
+func New(driver Driver, log logger.Logger) *Migrator {
+	return &Migrator{driver: driver, log: log}
+}
+
+// Up applies every pending migration
+func (m *Migrator) Up(ctx context.Context) error {
+	migrations := m.driver.Migrations()
+	if len(migrations) == 0 {
+		return nil
+	}
+
+	return m.To(ctx, migrations[len(migrations)-1].Version)
+}
+
+// Down reverts the latest applied migration
+func (m *Migrator) Down(ctx context.Context) error {
+	unlock, err := m.driver.Lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	current, err := m.current(ctx)
+	if err != nil {
+		return err
+	}
+	if current == 0 {
+		m.log.Info("migrate: nothing to revert")
+		return nil
+	}
+
+	return m.migrate(ctx, current, m.previous(current))
+}
+
+// To migrates up or down until the database is at version; 0 reverts
+// everything.
+func (m *Migrator) To(ctx context.Context, version uint) error {
+	if version != 0 && m.index(version) < 0 {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+
+	unlock, err := m.driver.Lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	current, err := m.current(ctx)
+	if err != nil {
+		return err
+	}
+	if current == version {
+		m.log.Info("migrate: no change", logger.Any("version", version))
+		return nil
+	}
+
+	return m.migrate(ctx, current, version)
+}
+
+// Force records version without running anything, clearing a dirty state
+func (m *Migrator) Force(ctx context.Context, version uint) error {
+	if version != 0 && m.index(version) < 0 {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+
+	unlock, err := m.driver.Lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return m.driver.Force(ctx, version)
+}
+
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	version, dirty, err := m.driver.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{Version: version, Dirty: dirty}
+	for _, migration := range m.driver.Migrations() {
+		status.Migrations = append(status.Migrations, MigrationStatus{
+			Migration: migration,
+			Applied:   migration.Version <= version,
+		})
+	}
+
+	return status, nil
+}
+
+// migrate walks from the current version to target one step at a time
+func (m *Migrator) migrate(ctx context.Context, current, target uint) error {
+	migrations := m.driver.Migrations()
+
+	if target > current {
+		for _, migration := range migrations {
+			if migration.Version <= current || migration.Version > target {
+				continue
+			}
+
+			m.log.Info("migrate: up", logger.Any("version", migration.Version), logger.String("name", migration.Name))
+			if err := m.driver.Up(ctx, migration); err != nil {
+				return fmt.Errorf("migration %d %s up: %w", migration.Version, migration.Name, err)
+			}
+		}
+
+		return nil
+	}
+
+	for i := len(migrations) - 1; i >= 0; i-- {
+		migration := migrations[i]
+		if migration.Version > current || migration.Version <= target {
+			continue
+		}
+
+		m.log.Info("migrate: down", logger.Any("version", migration.Version), logger.String("name", migration.Name))
+		if err := m.driver.Down(ctx, migration, m.previous(migration.Version)); err != nil {
+			return fmt.Errorf("migration %d %s down: %w", migration.Version, migration.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// current returns the recorded version, refusing dirty or unknown states
+func (m *Migrator) current(ctx context.Context) (uint, error) {
+	version, dirty, err := m.driver.Version(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w at version %d", ErrDirty, version)
+	}
+	if version != 0 && m.index(version) < 0 {
+		return 0, fmt.Errorf("database is at version %d, which this build does not know", version)
+	}
+
+	return version, nil
+}
+
+// previous returns the version before version, 0 for the first one
+func (m *Migrator) previous(version uint) uint {
+	i := m.index(version)
+	if i <= 0 {
+		return 0
+	}
+
+	return m.driver.Migrations()[i-1].Version
+}
+
+func (m *Migrator) index(version uint) int {
+	migrations := m.driver.Migrations()
+	i := sort.Search(len(migrations), func(i int) bool { return migrations[i].Version >= version })
+	if i < len(migrations) && migrations[i].Version == version {
+		return i
+	}
+
+	return -1
+}
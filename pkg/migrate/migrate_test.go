@@ -0,0 +1,133 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"exam/product-service/migrations"
+	"exam/product-service/pkg/logger"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDriver records the steps it is asked to run
+type fakeDriver struct {
+	migrations []Migration
+	version    uint
+	dirty      bool
+	failAt     uint
+	steps      []string
+}
+
+func (d *fakeDriver) Migrations() []Migration { return d.migrations }
+
+func (d *fakeDriver) Lock(ctx context.Context) (func(), error) { return func() {}, nil }
+
+func (d *fakeDriver) Version(ctx context.Context) (uint, bool, error) {
+	return d.version, d.dirty, nil
+}
+
+func (d *fakeDriver) Up(ctx context.Context, m Migration) error {
+	if m.Version == d.failAt {
+		return errors.New("boom")
+	}
+	d.steps = append(d.steps, "up "+m.Name)
+	d.version = m.Version
+	return nil
+}
+
+func (d *fakeDriver) Down(ctx context.Context, m Migration, previous uint) error {
+	d.steps = append(d.steps, "down "+m.Name)
+	d.version = previous
+	return nil
+}
+
+func (d *fakeDriver) Force(ctx context.Context, version uint) error {
+	d.version, d.dirty = version, false
+	return nil
+}
+
+func newFake() *fakeDriver {
+	return &fakeDriver{migrations: []Migration{{1, "a"}, {2, "b"}, {5, "c"}}}
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	log := logger.New("", "")
+
+	t.Run("up applies pending in order", func(t *testing.T) {
+		driver := newFake()
+		driver.version = 1
+
+		require.NoError(t, New(driver, log).Up(ctx))
+		assert.Equal(t, []string{"up b", "up c"}, driver.steps)
+		assert.Equal(t, uint(5), driver.version)
+	})
+
+	t.Run("down reverts one step", func(t *testing.T) {
+		driver := newFake()
+		driver.version = 5
+
+		require.NoError(t, New(driver, log).Down(ctx))
+		assert.Equal(t, []string{"down c"}, driver.steps)
+		assert.Equal(t, uint(2), driver.version)
+	})
+
+	t.Run("to walks both ways", func(t *testing.T) {
+		driver := newFake()
+		migrator := New(driver, log)
+
+		require.NoError(t, migrator.To(ctx, 2))
+		require.NoError(t, migrator.To(ctx, 0))
+		assert.Equal(t, []string{"up a", "up b", "down b", "down a"}, driver.steps)
+		assert.Equal(t, uint(0), driver.version)
+
+		assert.Error(t, migrator.To(ctx, 3))
+	})
+
+	t.Run("failures stop at the last good version", func(t *testing.T) {
+		driver := newFake()
+		driver.failAt = 5
+
+		assert.Error(t, New(driver, log).Up(ctx))
+		assert.Equal(t, uint(2), driver.version)
+	})
+
+	t.Run("dirty databases need force", func(t *testing.T) {
+		driver := newFake()
+		driver.version, driver.dirty = 2, true
+		migrator := New(driver, log)
+
+		assert.ErrorIs(t, migrator.Up(ctx), ErrDirty)
+		require.NoError(t, migrator.Force(ctx, 2))
+		require.NoError(t, migrator.Up(ctx))
+		assert.Equal(t, uint(5), driver.version)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		driver := newFake()
+		driver.version = 2
+
+		status, err := New(driver, log).Status(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), status.Version)
+		assert.True(t, status.Migrations[1].Applied)
+		assert.False(t, status.Migrations[2].Applied)
+	})
+}
+
+func TestLoadSQL(t *testing.T) {
+	loaded, err := loadSQL(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, loaded)
+	for i, m := range loaded {
+		assert.Equal(t, uint(i+1), m.Version, "migration versions must not skip")
+	}
+
+	_, err = loadSQL(fstest.MapFS{
+		"000001_x.up.sql": {Data: []byte("SELECT 1")},
+	})
+	assert.Error(t, err, "a missing down file is rejected")
+}
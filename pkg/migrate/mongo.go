@@ -0,0 +1,158 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// mongoLockTTL frees a lock left behind by a migrator that crashed
+	mongoLockTTL = 10 * time.Minute
+	// mongoLockRetry is how often a waiting migrator checks the lock
+	mongoLockRetry = 500 * time.Millisecond
+)
+
+// MongoMigration creates or drops collections and indexes. Mongo has no
+// transactional DDL, so Up and Down should be safe to run twice.
+type MongoMigration struct {
+	Migration
+	Up   func(ctx context.Context, database *mongo.Database) error
+	Down func(ctx context.Context, database *mongo.Database) error
+}
+
+// mongoDriver keeps its bookkeeping in the schema_migrations collection:
+// a "version" document and, while migrating, a "lock" document.
+type mongoDriver struct {
+	database   *mongo.Database
+	migrations []MongoMigration
+}
+
+func NewMongo(database *mongo.Database, migrations []MongoMigration) Driver {
+	sorted := append([]MongoMigration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	return &mongoDriver{database: database, migrations: sorted}
+}
+
+func (d *mongoDriver) Migrations() []Migration {
+	migrations := make([]Migration, 0, len(d.migrations))
+	for _, m := range d.migrations {
+		migrations = append(migrations, m.Migration)
+	}
+
+	return migrations
+}
+
+// Lock inserts the lock document, waiting while another migrator holds it
+func (d *mongoDriver) Lock(ctx context.Context) (func(), error) {
+	collection := d.collection()
+
+	for {
+		_, err := collection.InsertOne(ctx, bson.M{"_id": "lock", "locked_at": time.Now()})
+		if err == nil {
+			return func() {
+				_, _ = collection.DeleteOne(context.Background(), bson.M{"_id": "lock"})
+			}, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, fmt.Errorf("cannot take migration lock: %w", err)
+		}
+
+		_, err = collection.DeleteOne(ctx, bson.M{
+			"_id":       "lock",
+			"locked_at": bson.M{"$lt": time.Now().Add(-mongoLockTTL)},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("cannot take migration lock: %w", ctx.Err())
+		case <-time.After(mongoLockRetry):
+		}
+	}
+}
+
+func (d *mongoDriver) Version(ctx context.Context) (uint, bool, error) {
+	var doc struct {
+		Version int64 `bson:"version"`
+		Dirty   bool  `bson:"dirty"`
+	}
+
+	err := d.collection().FindOne(ctx, bson.M{"_id": "version"}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return uint(doc.Version), doc.Dirty, nil
+}
+
+// Up marks the version dirty while the step runs, so a crash halfway is
+// noticed by the next run.
+func (d *mongoDriver) Up(ctx context.Context, m Migration) error {
+	if err := d.record(ctx, m.Version, true); err != nil {
+		return err
+	}
+
+	if err := d.find(m.Version).Up(ctx, d.database); err != nil {
+		return err
+	}
+
+	return d.record(ctx, m.Version, false)
+}
+
+func (d *mongoDriver) Down(ctx context.Context, m Migration, previous uint) error {
+	if err := d.record(ctx, m.Version, true); err != nil {
+		return err
+	}
+
+	if err := d.find(m.Version).Down(ctx, d.database); err != nil {
+		return err
+	}
+
+	return d.record(ctx, previous, false)
+}
+
+func (d *mongoDriver) Force(ctx context.Context, version uint) error {
+	return d.record(ctx, version, false)
+}
+
+func (d *mongoDriver) record(ctx context.Context, version uint, dirty bool) error {
+	if version == 0 && !dirty {
+		_, err := d.collection().DeleteOne(ctx, bson.M{"_id": "version"})
+		return err
+	}
+
+	_, err := d.collection().UpdateOne(ctx,
+		bson.M{"_id": "version"},
+		bson.M{"$set": bson.M{"version": int64(version), "dirty": dirty}},
+		options.Update().SetUpsert(true),
+	)
+
+	return err
+}
+
+func (d *mongoDriver) find(version uint) MongoMigration {
+	for _, m := range d.migrations {
+		if m.Version == version {
+			return m
+		}
+	}
+
+	return MongoMigration{}
+}
+
+func (d *mongoDriver) collection() *mongo.Collection {
+	return d.database.Collection("schema_migrations")
+}
@@ -0,0 +1,188 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"exam/product-service/pkg/db"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// advisoryLockID keeps concurrent replicas from migrating at the same time
+const advisoryLockID = 7_305_661_204
+
+// fileName matches golang-migrate file names, e.g. 000001_create_products_table.up.sql
+var fileName = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+type sqlMigration struct {
+	Migration
+	up, down string
+}
+
+type postgresDriver struct {
+	db         *db.Postgres
+	migrations []sqlMigration
+}
+
+// NewPostgres reads the *.up.sql and *.down.sql files of fsys. Every
+// version needs both.
+func NewPostgres(pg *db.Postgres, fsys fs.FS) (Driver, error) {
+	migrations, err := loadSQL(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgresDriver{db: pg, migrations: migrations}, nil
+}
+
+func (d *postgresDriver) Migrations() []Migration {
+	migrations := make([]Migration, 0, len(d.migrations))
+	for _, m := range d.migrations {
+		migrations = append(migrations, m.Migration)
+	}
+
+	return migrations
+}
+
+// Lock takes a session advisory lock, released by unlock
+func (d *postgresDriver) Lock(ctx context.Context) (func(), error) {
+	conn, err := d.db.DB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, advisoryLockID); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("cannot take migration lock: %w", err)
+	}
+
+	return func() {
+		_, _ = conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, advisoryLockID)
+		conn.Close()
+	}, nil
+}
+
+func (d *postgresDriver) Version(ctx context.Context) (uint, bool, error) {
+	var (
+		version uint
+		dirty   bool
+	)
+
+	// Same table golang-migrate keeps, so databases it migrated carry on
+	_, err := d.db.DB.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT NOT NULL PRIMARY KEY,
+		dirty BOOLEAN NOT NULL
+	)`)
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = d.db.DB.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return version, dirty, nil
+}
+
+// Up runs the migration and records it in one transaction, so a failed
+// step leaves neither schema changes nor a dirty version behind.
+func (d *postgresDriver) Up(ctx context.Context, m Migration) error {
+	return d.run(ctx, d.find(m.Version).up, m.Version)
+}
+
+func (d *postgresDriver) Down(ctx context.Context, m Migration, previous uint) error {
+	return d.run(ctx, d.find(m.Version).down, previous)
+}
+
+func (d *postgresDriver) Force(ctx context.Context, version uint) error {
+	return d.run(ctx, "", version)
+}
+
+func (d *postgresDriver) run(ctx context.Context, statements string, version uint) error {
+	return d.db.WithTx(ctx, func(tx *sql.Tx) error {
+		if statements != "" {
+			if _, err := tx.ExecContext(ctx, statements); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations`); err != nil {
+			return err
+		}
+		if version == 0 {
+			return nil
+		}
+
+		_, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES ($1, false)`, version)
+		return err
+	})
+}
+
+func (d *postgresDriver) find(version uint) sqlMigration {
+	for _, m := range d.migrations {
+		if m.Version == version {
+			return m
+		}
+	}
+
+	return sqlMigration{}
+}
+
+func loadSQL(fsys fs.FS) ([]sqlMigration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint]*sqlMigration)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[uint(version)]
+		if !ok {
+			m = &sqlMigration{Migration: Migration{Version: uint(version), Name: match[2]}}
+			byVersion[uint(version)] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.up = string(body)
+		} else {
+			m.down = string(body)
+		}
+	}
+
+	migrations := make([]sqlMigration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" || m.down == "" {
+			return nil, fmt.Errorf("migration %d %s needs both an up and a down file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
@@ -0,0 +1,181 @@
+// Package search holds the text handling shared by the storage backends:
+// query parsing, typo-tolerant word matching, scoring for backends without
+// a ranking engine of their own, and highlighting of matched words.
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxFuzzyTerms is the longest query, in terms, that gets typo tolerance.
+// Longer queries carry enough context to match exactly.
+const MaxFuzzyTerms = 2
+
+const (
+	nameWeight        = 1.0
+	descriptionWeight = 0.4
+	// fuzzyPenalty scales the weight of a word matched with typos
+	fuzzyPenalty = 0.5
+)
+
+// Query is a parsed search query
+type Query struct {
+	// Text is the query as typed, for engines that parse it themselves
+	Text string
+	// Terms are the lowercase words a product must contain; negated words
+	// and operators are left out
+	Terms []string
+	// Fuzzy allows typos in Terms
+	Fuzzy bool
+}
+
+// Parse splits text into terms the way websearch_to_tsquery reads it:
+// "-word" excludes a word and a bare "or" is an operator, not a term
+func Parse(text string) Query {
+	query := Query{Text: strings.TrimSpace(text)}
+	for _, field := range strings.Fields(text) {
+		if strings.HasPrefix(field, "-") || strings.EqualFold(field, "or") {
+			continue
+		}
+		query.Terms = append(query.Terms, Words(field)...)
+	}
+	query.Fuzzy = len(query.Terms) > 0 && len(query.Terms) <= MaxFuzzyTerms
+
+	return query
+}
+
+// Words returns the lowercase words of text
+func Words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), isSeparator)
+}
+
+// Match reports whether word satisfies term, allowing typos when fuzzy is set
+func Match(term, word string, fuzzy bool) bool {
+	if term == word {
+		return true
+	}
+
+	return fuzzy && Distance(term, word) <= MaxEdits(term)
+}
+
+// MaxEdits is the number of typos tolerated in term: none for very short
+// words, where one edit already yields a different word
+func MaxEdits(term string) int {
+	switch n := utf8.RuneCountInString(term); {
+	case n <= 3:
+		return 0
+	case n <= 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Score rates how well a product matches q. Every term has to occur in the
+// name or the description; 0 means no match.
+func (q Query) Score(name, description string) float64 {
+	nameWords, descriptionWords := Words(name), Words(description)
+
+	var score float64
+	for _, term := range q.Terms {
+		best := q.weight(term, nameWords, nameWeight)
+		if best == 0 {
+			best = q.weight(term, descriptionWords, descriptionWeight)
+		}
+		if best == 0 {
+			return 0
+		}
+		score += best
+	}
+
+	return score
+}
+
+func (q Query) weight(term string, words []string, weight float64) float64 {
+	best := 0.0
+	for _, word := range words {
+		switch {
+		case word == term:
+			return weight
+		case q.Fuzzy && Match(term, word, true):
+			best = weight * fuzzyPenalty
+		}
+	}
+
+	return best
+}
+
+// Highlight HTML-escapes text and wraps every word matching one of the
+// terms of q in <em></em>
+func (q Query) Highlight(text string) string {
+	var b strings.Builder
+
+	for len(text) > 0 {
+		end := strings.IndexFunc(text, isSeparator)
+		if end == 0 {
+			// copy the run of separators up to the next word
+			end = strings.IndexFunc(text, func(r rune) bool { return !isSeparator(r) })
+			if end < 0 {
+				end = len(text)
+			}
+			b.WriteString(html.EscapeString(text[:end]))
+			text = text[end:]
+			continue
+		}
+		if end < 0 {
+			end = len(text)
+		}
+
+		word := text[:end]
+		if q.matches(strings.ToLower(word)) {
+			b.WriteString("<em>" + html.EscapeString(word) + "</em>")
+		} else {
+			b.WriteString(html.EscapeString(word))
+		}
+		text = text[end:]
+	}
+
+	return b.String()
+}
+
+func (q Query) matches(word string) bool {
+	for _, term := range q.Terms {
+		if Match(term, word, q.Fuzzy) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Distance is the Levenshtein distance between a and b
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
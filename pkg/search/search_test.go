@@ -0,0 +1,55 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	query := Parse(`  "steel kettle" or -plastic  `)
+
+	assert.Equal(t, `"steel kettle" or -plastic`, query.Text)
+	assert.Equal(t, []string{"steel", "kettle"}, query.Terms)
+	assert.True(t, query.Fuzzy)
+
+	assert.False(t, Parse("large steel electric kettle").Fuzzy)
+	assert.Empty(t, Parse("-plastic").Terms)
+}
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, Distance("kettle", "kettle"))
+	assert.Equal(t, 1, Distance("ketle", "kettle"))
+	assert.Equal(t, 2, Distance("kettel", "kettle"))
+	assert.Equal(t, 3, Distance("", "tea"))
+	assert.Equal(t, 1, Distance("čaj", "čai"))
+}
+
+func TestMatch(t *testing.T) {
+	assert.True(t, Match("ketle", "kettle", true))
+	assert.False(t, Match("ketle", "kettle", false))
+	// short words tolerate no typos
+	assert.False(t, Match("tea", "pea", true))
+}
+
+func TestScore(t *testing.T) {
+	query := Parse("kettle")
+
+	inName := query.Score("Steel kettle", "")
+	inDescription := query.Score("Teapot", "Pairs with any kettle")
+	typo := Parse("ketle").Score("Steel kettle", "")
+
+	assert.Greater(t, inName, inDescription)
+	assert.Greater(t, inDescription, 0.0)
+	assert.Greater(t, typo, 0.0)
+	assert.Less(t, typo, inName)
+	assert.Zero(t, Parse("kettle toaster").Score("Steel kettle", ""))
+}
+
+func TestHighlight(t *testing.T) {
+	query := Parse("kettle")
+
+	assert.Equal(t, "Steel <em>Kettle</em>, 1.7l", query.Highlight("Steel Kettle, 1.7l"))
+	assert.Equal(t, "&lt;b&gt;<em>kettle</em>&lt;/b&gt;", query.Highlight("<b>kettle</b>"))
+	assert.Equal(t, "", query.Highlight(""))
+}
@@ -5,7 +5,7 @@ for module in $(find $CURRENT_DIR/protos/* -type d); do
     protoc -I /usr/local/include \
            -I $GOPATH/pkg/mod/github.com/gogo/protobuf@v1.3.2 \
            -I $CURRENT_DIR/protos/ \
-            --gofast_out=plugins=grpc:$CURRENT_DIR/genproto/ \
+            --gofast_out=plugins=grpc,Mgoogle/protobuf/field_mask.proto=github.com/gogo/protobuf/types:$CURRENT_DIR/genproto/ \
             $module/*.proto;
 done;
 
@@ -0,0 +1,39 @@
+package interceptor
+
+import (
+	"context"
+	"exam/product-service/pkg/actor"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Actor puts the caller named in the x-actor header into the request
+// context, so the storage layer can record who changed what
+func Actor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if values := metadata.ValueFromIncomingContext(ctx, actor.Header); len(values) > 0 {
+			ctx = actor.With(ctx, actorName(values[0]))
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// actorName cleans a header value up for storage: valid UTF-8 of at most
+// actor.MaxLength bytes, cut on a rune boundary
+func actorName(value string) string {
+	name := strings.TrimSpace(strings.ToValidUTF8(value, ""))
+	if len(name) <= actor.MaxLength {
+		return name
+	}
+
+	end := actor.MaxLength
+	for end > 0 && !utf8.RuneStart(name[end]) {
+		end--
+	}
+
+	return name[:end]
+}
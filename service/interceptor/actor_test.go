@@ -0,0 +1,66 @@
+package interceptor
+
+import (
+	"context"
+	"exam/product-service/pkg/actor"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestActor(t *testing.T) {
+	cases := []struct {
+		name string
+		md   metadata.MD
+		want string
+	}{
+		{
+			name: "no header",
+			md:   metadata.MD{},
+			want: actor.System,
+		},
+		{
+			name: "header is trimmed",
+			md:   metadata.Pairs(actor.Header, "  alice "),
+			want: "alice",
+		},
+		{
+			name: "blank header",
+			md:   metadata.Pairs(actor.Header, "   "),
+			want: actor.System,
+		},
+		{
+			name: "long name is cut on a rune boundary",
+			// é takes two bytes, so MaxLength falls inside one
+			md:   metadata.Pairs(actor.Header, strings.Repeat("é", actor.MaxLength)),
+			want: strings.Repeat("é", actor.MaxLength/2),
+		},
+		{
+			name: "invalid UTF-8 is dropped",
+			md:   metadata.Pairs(actor.Header, "bob\xff"),
+			want: "bob",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+
+			var got string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = actor.From(ctx)
+				return nil, nil
+			}
+
+			_, err := Actor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/product.ProductService/IncreaseProductAmount"}, handler)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.True(t, utf8.ValidString(got))
+			assert.LessOrEqual(t, len(got), actor.MaxLength)
+		})
+	}
+}
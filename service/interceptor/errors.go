@@ -0,0 +1,45 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/pkg/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors translates handler errors into gRPC statuses: domain errors keep
+// their code and details, anything unexpected is logged and hidden behind
+// codes.Internal.
+func Errors(log logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		return nil, toStatus(log, info.FullMethod, err)
+	}
+}
+
+func toStatus(log logger.Logger, method string, err error) error {
+	var domainErr *errs.Error
+	if errors.As(err, &domainErr) {
+		return domainErr.GRPCStatus().Err()
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	log.Error("unexpected error", logger.String("method", method), logger.Error(err))
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,20 @@
+package interceptor
+
+import (
+	"context"
+	"exam/product-service/service/validation"
+
+	"google.golang.org/grpc"
+)
+
+// Validation rejects requests that break the rules declared in package
+// validation before the handler runs.
+func Validation() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validation.Validate(info.FullMethod, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
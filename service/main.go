@@ -4,48 +4,48 @@ import (
 	"context"
 	"exam/product-service/config"
 	pb "exam/product-service/genproto/product-service"
-	// "exam/product-service/pkg/db"
 	"exam/product-service/pkg/logger"
 	grpcClient2 "exam/product-service/service/grpc_client"
+	"exam/product-service/service/interceptor"
 	"exam/product-service/service/service"
 	storage2 "exam/product-service/storage"
 	"fmt"
 	"net"
 
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
 )
 
 type Service struct {
 	ProductService *service.ProductService
+	storage        storage2.StorageI
 }
 
 func New(cfg *config.Config, log logger.Logger) (*Service, error) {
-	// postgres, err := db.New(*cfg)
-	// if err != nil {
-	// 	return nil, fmt.Errorf("cannot connect to database:", err.Error())
-	// }
-	// storage := storage2.New(postgres, log)
-
-	clientOptions := options.Client().ApplyURI("mongodb://mongodb:27017")
-	client, err := mongo.Connect(context.Background(), clientOptions)
+	storage, err := storage2.New(*cfg, log)
 	if err != nil {
 		return nil, err
 	}
 
-	database := client.Database("productdb")
-	storage := storage2.New(database, log)
 	grpcClient, err := grpcClient2.New(*cfg)
 	if err != nil {
+		storage.Close()
 		return nil, fmt.Errorf("cannot connect to grpc client:%v", err.Error())
 	}
 
-	return &Service{ProductService: service.NewProductService(storage, log, grpcClient)}, nil
+	return &Service{
+		ProductService: service.NewProductService(storage, log, grpcClient),
+		storage:        storage,
+	}, nil
 }
 
 func (s *Service) Run(log logger.Logger, cfg *config.Config) {
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.Errors(log),
+			interceptor.Validation(),
+			interceptor.Actor(),
+		),
+	)
 
 	pb.RegisterProductServiceServer(server, s.ProductService)
 
@@ -56,11 +56,14 @@ func (s *Service) Run(log logger.Logger, cfg *config.Config) {
 	}
 
 	defer logger.Cleanup(log)
+	defer s.storage.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sweepReservations(ctx, s.storage.ProductService(), log, cfg.SweepInterval)
 
-	log.Info("main: sqlConfig",
-		logger.String("host", cfg.PostgresHost),
-		logger.Int("port", cfg.PostgresPort),
-		logger.String("database", cfg.PostgresDatabase),
+	log.Info("main: storageConfig",
+		logger.String("driver", cfg.StorageDriver),
 		logger.String("rpc port", cfg.RPCPort))
 
 	if err := server.Serve(listen); err != nil {
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+)
+
+func (c *ProductService) CreateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	return c.storage.ProductService().CreateCategory(ctx, req)
+}
+
+func (c *ProductService) GetCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Category, error) {
+	return c.storage.ProductService().GetCategory(ctx, req)
+}
+
+func (c *ProductService) UpdateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	return c.storage.ProductService().UpdateCategory(ctx, req)
+}
+
+func (c *ProductService) DeleteCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Status, error) {
+	return c.storage.ProductService().DeleteCategory(ctx, req)
+}
+
+func (c *ProductService) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	return c.storage.ProductService().ListCategories(ctx, req)
+}
+
+func (c *ProductService) SetProductCategories(ctx context.Context, req *pb.ProductCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	return c.storage.ProductService().SetProductCategories(ctx, req)
+}
+
+func (c *ProductService) GetProductCategories(ctx context.Context, req *pb.GetProductId) (*pb.ListCategoriesResponse, error) {
+	return c.storage.ProductService().GetProductCategories(ctx, req)
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+)
+
+func (c *ProductService) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	return c.storage.ProductService().ListStockMovements(ctx, req)
+}
+
+func (c *ProductService) ReconcileStock(ctx context.Context, req *pb.ReconcileStockRequest) (*pb.ReconcileStockResponse, error) {
+	return c.storage.ProductService().ReconcileStock(ctx, req)
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+)
+
+func (c *ProductService) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.Order, error) {
+	return c.storage.ProductService().CreateOrder(ctx, req)
+}
+
+func (c *ProductService) GetOrder(ctx context.Context, req *pb.GetOrderId) (*pb.Order, error) {
+	return c.storage.ProductService().GetOrder(ctx, req)
+}
+
+func (c *ProductService) ListOrdersByUser(ctx context.Context, req *pb.ListOrdersByUserRequest) (*pb.ListOrdersResponse, error) {
+	return c.storage.ProductService().ListOrdersByUser(ctx, req)
+}
+
+func (c *ProductService) TransitionOrder(ctx context.Context, req *pb.TransitionOrderRequest) (*pb.Order, error) {
+	return c.storage.ProductService().TransitionOrder(ctx, req)
+}
+
+func (c *ProductService) ReturnOrderItem(ctx context.Context, req *pb.ReturnOrderItemRequest) (*pb.OrderReturn, error) {
+	return c.storage.ProductService().ReturnOrderItem(ctx, req)
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
 	"exam/product-service/pkg/logger"
 	grpcClient "exam/product-service/service/grpc_client"
 	"exam/product-service/storage"
@@ -30,7 +32,15 @@ func (c *ProductService) GetProductById(ctx context.Context, req *pb.GetProductI
 	return c.storage.ProductService().GetProductById(ctx, req)
 }
 
+func (c *ProductService) GetProductsByIds(ctx context.Context, req *pb.GetProductsByIdsRequest) (*pb.GetProductsByIdsResponse, error) {
+	return c.storage.ProductService().GetProductsByIds(ctx, req)
+}
+
 func (c *ProductService) UpdateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error) {
+	return c.storage.ProductService().UpdateProduct(ctx, &pb.UpdateProductRequest{Product: req})
+}
+
+func (c *ProductService) PatchProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
 	return c.storage.ProductService().UpdateProduct(ctx, req)
 }
 
@@ -47,7 +57,13 @@ func (c *ProductService) IncreaseProductAmount(ctx context.Context, req *pb.Prod
 }
 
 func (c *ProductService) DecreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
-	return c.storage.ProductService().DecreaseProductAmount(ctx, req)
+	response, err := c.storage.ProductService().DecreaseProductAmount(ctx, req)
+	if errors.Is(err, errs.ErrInsufficientStock) {
+		// Not enough stock is an answer, not a failure: report it via is_enough.
+		return response, nil
+	}
+
+	return response, err
 }
 
 func (c *ProductService) CheckAmount(ctx context.Context, req *pb.GetProductId) (*pb.CheckAmountResponse, error) {
@@ -55,9 +71,29 @@ func (c *ProductService) CheckAmount(ctx context.Context, req *pb.GetProductId)
 }
 
 func (c *ProductService) BuyProduct(ctx context.Context, req *pb.BuyProductRequest) (*pb.Product, error) {
+	if req.Amount <= 0 {
+		return nil, errs.InvalidArgument("amount must be positive")
+	}
+
 	return c.storage.ProductService().BuyProduct(ctx, req)
 }
 
 func (c *ProductService) GetPurchasedProductsByUserId(ctx context.Context, req *pb.GetUserID) (*pb.GetPurchasedProductsResponse, error) {
 	return c.storage.ProductService().GetPurchasedProductsByUserId(ctx, req)
 }
+
+func (c *ProductService) RestoreProduct(ctx context.Context, req *pb.GetProductId) (*pb.Product, error) {
+	return c.storage.ProductService().RestoreProduct(ctx, req)
+}
+
+func (c *ProductService) PurgeDeletedProducts(ctx context.Context, req *pb.PurgeDeletedProductsRequest) (*pb.PurgeDeletedProductsResponse, error) {
+	return c.storage.ProductService().PurgeDeletedProducts(ctx, req)
+}
+
+func (c *ProductService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	return c.storage.ProductService().SearchProducts(ctx, req)
+}
+
+func (c *ProductService) SuggestProducts(ctx context.Context, req *pb.SuggestProductsRequest) (*pb.SuggestProductsResponse, error) {
+	return c.storage.ProductService().SuggestProducts(ctx, req)
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"exam/product-service/config"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/logger"
+	"exam/product-service/storage"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ProductServiceTestSuite struct {
+	suite.Suite
+	Service *ProductService
+}
+
+func (s *ProductServiceTestSuite) SetupTest() {
+	log := logger.New("", "")
+	store, err := storage.New(config.Config{StorageDriver: storage.DriverMemory}, log)
+	s.Suite.Require().NoError(err)
+
+	s.Service = NewProductService(store, log, nil)
+}
+
+func (s *ProductServiceTestSuite) createProduct(ctx context.Context, amount int32) *pb.Product {
+	product, err := s.Service.CreateProduct(ctx, &pb.Product{
+		Name:        gofakeit.FirstName(),
+		Description: gofakeit.ProductDescription(),
+		Price:       float32(gofakeit.Price(10.1, 19.2)),
+		Amount:      amount,
+	})
+	s.Suite.Require().NoError(err)
+
+	return product
+}
+
+func (s *ProductServiceTestSuite) TestBuyProduct() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(7))
+	defer cancel()
+
+	product := s.createProduct(ctx, 3)
+	userId := uuid.New().String()
+
+	//Invalid amount
+	_, err := s.Service.BuyProduct(ctx, &pb.BuyProductRequest{UserId: userId, ProductId: product.Id, Amount: 0})
+	s.Suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	//Successful purchase
+	bought, err := s.Service.BuyProduct(ctx, &pb.BuyProductRequest{UserId: userId, ProductId: product.Id, Amount: 2})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int32(1), bought.Amount)
+
+	//Insufficient stock
+	_, err = s.Service.BuyProduct(ctx, &pb.BuyProductRequest{UserId: userId, ProductId: product.Id, Amount: 2})
+	s.Suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	purchased, err := s.Service.GetPurchasedProductsByUserId(ctx, &pb.GetUserID{UserId: userId})
+	s.Suite.NoError(err)
+	s.Suite.Len(purchased.Products, 1)
+}
+
+func (s *ProductServiceTestSuite) TestDecreaseProductAmount() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(7))
+	defer cancel()
+
+	product := s.createProduct(ctx, 1)
+
+	resp, err := s.Service.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 2})
+	s.Suite.NoError(err)
+	s.Suite.False(resp.IsEnough)
+	s.Suite.Equal(int32(1), resp.Product.Amount)
+
+	resp, err = s.Service.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 1})
+	s.Suite.NoError(err)
+	s.Suite.True(resp.IsEnough)
+	s.Suite.Equal(int32(0), resp.Product.Amount)
+}
+
+func TestProductService(t *testing.T) {
+	suite.Run(t, new(ProductServiceTestSuite))
+}
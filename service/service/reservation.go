@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+)
+
+func (c *ProductService) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.Reservation, error) {
+	return c.storage.ProductService().ReserveStock(ctx, req)
+}
+
+func (c *ProductService) CommitReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error) {
+	return c.storage.ProductService().CommitReservation(ctx, req)
+}
+
+func (c *ProductService) ReleaseReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error) {
+	return c.storage.ProductService().ReleaseReservation(ctx, req)
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"exam/product-service/pkg/logger"
+	"exam/product-service/storage/repo"
+	"time"
+)
+
+// sweepReservations releases expired stock reservations every interval
+// until ctx is done; a non-positive interval turns sweeping off
+func sweepReservations(ctx context.Context, reservations repo.ReservationServiceI, log logger.Logger, interval time.Duration) {
+	if interval <= 0 {
+		log.Warn("reservation sweeper is off, expired reservations keep holding stock")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			released, err := reservations.ReleaseExpiredReservations(ctx, now)
+			if err != nil {
+				log.Error("error while releasing expired reservations", logger.Error(err))
+				continue
+			}
+			if released > 0 {
+				log.Info("released expired reservations", logger.Int("count", int(released)))
+			}
+		}
+	}
+}
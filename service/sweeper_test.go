@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"exam/product-service/config"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/logger"
+	"exam/product-service/storage"
+	"exam/product-service/storage/repo"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// laterClock sweeps as if it were after now, so reservations made with the
+// default TTL are already expired; the first fails sweeps fail
+type laterClock struct {
+	repo.ReservationServiceI
+	after time.Duration
+	fails int32
+	calls atomic.Int32
+}
+
+func (c *laterClock) ReleaseExpiredReservations(ctx context.Context, now time.Time) (int64, error) {
+	if c.calls.Add(1) <= c.fails {
+		return 0, errors.New("sweep failed")
+	}
+	return c.ReservationServiceI.ReleaseExpiredReservations(ctx, now.Add(c.after))
+}
+
+func TestSweepReservations(t *testing.T) {
+	log := logger.New("", "")
+	store, err := storage.New(config.Config{StorageDriver: storage.DriverMemory}, log)
+	require.NoError(t, err)
+	products := store.ProductService()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	product, err := products.CreateProduct(ctx, &pb.Product{Name: "Kettle", Price: 10, Amount: 5})
+	require.NoError(t, err)
+	_, err = products.ReserveStock(ctx, &pb.ReserveStockRequest{ProductId: product.Id, Quantity: 2})
+	require.NoError(t, err)
+
+	reserved := func() int32 {
+		check, err := products.CheckAmount(ctx, &pb.GetProductId{ProductId: product.Id})
+		require.NoError(t, err)
+		return check.Reserved
+	}
+
+	//A non-positive interval turns sweeping off
+	clock := &laterClock{ReservationServiceI: products, after: repo.DefaultReservationTTL + time.Minute, fails: 1}
+	sweepReservations(ctx, clock, log, 0)
+	require.Zero(t, clock.calls.Load())
+	require.Equal(t, int32(2), reserved())
+
+	//Expired reservations are released, a failed sweep does not stop the next
+	sweepCtx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		sweepReservations(sweepCtx, clock, log, 10*time.Millisecond)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return reserved() == 0 }, time.Second, 10*time.Millisecond)
+	require.GreaterOrEqual(t, clock.calls.Load(), int32(2))
+
+	//The sweeper returns once its context is cancelled
+	stop()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sweeper did not return after cancel")
+	}
+}
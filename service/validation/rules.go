@@ -0,0 +1,118 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Check inspects a single value and returns what is wrong with it, or ""
+type Check[T any] func(value T) string
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+type number interface {
+	~int32 | ~int64 | ~float32 | ~float64
+}
+
+// Required rejects empty and whitespace-only strings
+func Required(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "must not be empty"
+	}
+
+	return ""
+}
+
+// MaxLength limits a string to n characters
+func MaxLength(n int) Check[string] {
+	return func(value string) string {
+		if utf8.RuneCountInString(value) > n {
+			return fmt.Sprintf("must be at most %d characters", n)
+		}
+
+		return ""
+	}
+}
+
+// UUID requires a canonical UUID string
+func UUID(value string) string {
+	if _, err := uuid.Parse(value); err != nil {
+		return "must be a valid UUID"
+	}
+
+	return ""
+}
+
+// Slug requires lowercase letters and digits separated by single dashes
+func Slug(value string) string {
+	if !slugPattern.MatchString(value) {
+		return "must be lowercase letters and digits separated by dashes"
+	}
+
+	return ""
+}
+
+// Timestamp requires an RFC 3339 timestamp
+func Timestamp(value string) string {
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		return "must be an RFC 3339 timestamp"
+	}
+
+	return ""
+}
+
+// Positive requires a value greater than zero
+func Positive[T number](value T) string {
+	if value <= 0 {
+		return "must be greater than 0"
+	}
+
+	return ""
+}
+
+// NonNegative requires a value of zero or more
+func NonNegative[T number](value T) string {
+	if value < 0 {
+		return "must not be negative"
+	}
+
+	return ""
+}
+
+// Between requires min <= value <= max
+func Between[T number](min, max T) Check[T] {
+	return func(value T) string {
+		if value < min || value > max {
+			return fmt.Sprintf("must be between %v and %v", min, max)
+		}
+
+		return ""
+	}
+}
+
+// Enum requires one of the values declared in a generated proto enum
+func Enum[T ~int32](names map[int32]string) Check[T] {
+	return func(value T) string {
+		if _, ok := names[int32(value)]; !ok {
+			return fmt.Sprintf("unknown value %d", value)
+		}
+
+		return ""
+	}
+}
+
+// Optional runs check only against non-empty strings
+func Optional(check Check[string]) Check[string] {
+	return func(value string) string {
+		if value == "" {
+			return ""
+		}
+
+		return check(value)
+	}
+}
@@ -0,0 +1,355 @@
+// Package validation declares the input rules of every ProductService RPC
+// and checks requests against them before they reach the storage layer.
+package validation
+
+import (
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/storage/repo"
+	"fmt"
+	"time"
+)
+
+const (
+	// MaxPageSize caps GetListRequest.limit
+	MaxPageSize = 100
+	// MaxSuggestions caps SuggestProductsRequest.limit
+	MaxSuggestions = 20
+
+	maxNameLength        = 255
+	maxSlugLength        = 255
+	maxDescriptionLength = 5000
+	maxQueryLength       = 200
+)
+
+// rules returns the violations of a request, keyed by full gRPC method name
+var rules = map[string]func(req interface{}) []errs.FieldViolation{
+	"/product.ProductService/CreateProduct": func(req interface{}) []errs.FieldViolation {
+		return product(req.(*pb.Product))
+	},
+	"/product.ProductService/GetProductById": func(req interface{}) []errs.FieldViolation {
+		return productId(req.(*pb.GetProductId))
+	},
+	"/product.ProductService/GetProductsByIds": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.GetProductsByIdsRequest)
+		violations := Field("product_ids", int32(len(r.ProductIds)), Between[int32](1, MaxPageSize))
+		for i, id := range r.ProductIds {
+			violations = append(violations, Field(fmt.Sprintf("product_ids[%d]", i), id, Positive[int32])...)
+		}
+		return violations
+	},
+	"/product.ProductService/UpdateProduct": func(req interface{}) []errs.FieldViolation {
+		return replaceProduct(req.(*pb.Product))
+	},
+	"/product.ProductService/PatchProduct": func(req interface{}) []errs.FieldViolation {
+		return updateProduct(req.(*pb.UpdateProductRequest))
+	},
+	"/product.ProductService/DeleteProduct": func(req interface{}) []errs.FieldViolation {
+		return productId(req.(*pb.GetProductId))
+	},
+	"/product.ProductService/ListProducts": func(req interface{}) []errs.FieldViolation {
+		return listProducts(req.(*pb.GetListRequest))
+	},
+	"/product.ProductService/IncreaseProductAmount": func(req interface{}) []errs.FieldViolation {
+		return productAmount(req.(*pb.ProductAmountRequest))
+	},
+	"/product.ProductService/DecreaseProductAmount": func(req interface{}) []errs.FieldViolation {
+		return productAmount(req.(*pb.ProductAmountRequest))
+	},
+	"/product.ProductService/CheckAmount": func(req interface{}) []errs.FieldViolation {
+		return productId(req.(*pb.GetProductId))
+	},
+	"/product.ProductService/BuyProduct": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.BuyProductRequest)
+		return collect(
+			Field("user_id", r.UserId, UUID),
+			Field("product_id", r.ProductId, Positive[int32]),
+			Field("amount", r.Amount, Positive[int32]),
+		)
+	},
+	"/product.ProductService/GetPurchasedProductsByUserId": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.GetUserID)
+		return Field("user_id", r.UserId, UUID)
+	},
+	"/product.ProductService/RestoreProduct": func(req interface{}) []errs.FieldViolation {
+		return productId(req.(*pb.GetProductId))
+	},
+	"/product.ProductService/PurgeDeletedProducts": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.PurgeDeletedProductsRequest)
+		return Field("older_than", r.OlderThan, Required, Timestamp)
+	},
+	"/product.ProductService/SearchProducts": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.SearchProductsRequest)
+		return collect(
+			Field("query", r.Query, Required, MaxLength(maxQueryLength)),
+			Field("page", r.Page, pageRule(r.PageToken)),
+			Field("limit", r.Limit, Between[int32](1, MaxPageSize)),
+			Field("category_id", r.CategoryId, NonNegative[int32]),
+			priceBounds(r.PriceBounds),
+		)
+	},
+	"/product.ProductService/SuggestProducts": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.SuggestProductsRequest)
+		return collect(
+			Field("prefix", r.Prefix, Required, MaxLength(maxNameLength)),
+			Field("limit", r.Limit, Between[int32](1, MaxSuggestions)),
+		)
+	},
+	"/product.ProductService/CreateOrder": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.CreateOrderRequest)
+		violations := collect(
+			Field("user_id", r.UserId, UUID),
+			Field("items", int32(len(r.Items)), Between[int32](1, MaxPageSize)),
+		)
+		for i, line := range r.Items {
+			violations = append(violations, collect(
+				Field(fmt.Sprintf("items[%d].product_id", i), line.ProductId, Positive[int32]),
+				Field(fmt.Sprintf("items[%d].quantity", i), line.Quantity, Positive[int32]),
+			)...)
+		}
+		return violations
+	},
+	"/product.ProductService/GetOrder": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.GetOrderId)
+		return Field("order_id", r.OrderId, Positive[int32])
+	},
+	"/product.ProductService/ListOrdersByUser": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.ListOrdersByUserRequest)
+		return collect(
+			Field("user_id", r.UserId, UUID),
+			Field("page", r.Page, Positive[int32]),
+			Field("limit", r.Limit, Between[int32](1, MaxPageSize)),
+		)
+	},
+	"/product.ProductService/TransitionOrder": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.TransitionOrderRequest)
+		return collect(
+			Field("order_id", r.OrderId, Positive[int32]),
+			Field("status", r.Status, Enum[pb.OrderStatus](pb.OrderStatus_name)),
+		)
+	},
+	"/product.ProductService/ReturnOrderItem": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.ReturnOrderItemRequest)
+		return collect(
+			Field("order_id", r.OrderId, Positive[int32]),
+			Field("product_id", r.ProductId, Positive[int32]),
+			Field("quantity", r.Quantity, Positive[int32]),
+			Field("reason", r.Reason, Enum[pb.ReturnReason](pb.ReturnReason_name)),
+		)
+	},
+	"/product.ProductService/ReserveStock": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.ReserveStockRequest)
+		return collect(
+			Field("product_id", r.ProductId, Positive[int32]),
+			Field("quantity", r.Quantity, Positive[int32]),
+			Field("ttl_seconds", r.TtlSeconds, Between[int32](0, int32(repo.MaxReservationTTL/time.Second))),
+		)
+	},
+	"/product.ProductService/CommitReservation": func(req interface{}) []errs.FieldViolation {
+		return reservationId(req.(*pb.GetReservationId))
+	},
+	"/product.ProductService/ReleaseReservation": func(req interface{}) []errs.FieldViolation {
+		return reservationId(req.(*pb.GetReservationId))
+	},
+	"/product.ProductService/ListStockMovements": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.ListStockMovementsRequest)
+		return collect(
+			Field("product_id", r.ProductId, Positive[int32]),
+			Field("from", r.From, Optional(Timestamp)),
+			Field("to", r.To, Optional(Timestamp)),
+			Field("page", r.Page, Positive[int32]),
+			Field("limit", r.Limit, Between[int32](1, MaxPageSize)),
+		)
+	},
+	"/product.ProductService/ReconcileStock": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.ReconcileStockRequest)
+		return Field("product_id", r.ProductId, NonNegative[int32])
+	},
+	"/product.ProductService/CreateCategory": func(req interface{}) []errs.FieldViolation {
+		return category(req.(*pb.Category))
+	},
+	"/product.ProductService/GetCategory": func(req interface{}) []errs.FieldViolation {
+		return categoryId(req.(*pb.GetCategoryId))
+	},
+	"/product.ProductService/UpdateCategory": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.Category)
+		return collect(
+			Field("id", r.Id, Positive[int32]),
+			category(r),
+		)
+	},
+	"/product.ProductService/DeleteCategory": func(req interface{}) []errs.FieldViolation {
+		return categoryId(req.(*pb.GetCategoryId))
+	},
+	"/product.ProductService/ListCategories": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.ListCategoriesRequest)
+		return Field("parent_id", r.ParentId, NonNegative[int32])
+	},
+	"/product.ProductService/SetProductCategories": func(req interface{}) []errs.FieldViolation {
+		r := req.(*pb.ProductCategoriesRequest)
+		violations := Field("product_id", r.ProductId, Positive[int32])
+		for i, id := range r.CategoryIds {
+			violations = append(violations, Field(fmt.Sprintf("category_ids[%d]", i), id, Positive[int32])...)
+		}
+		return violations
+	},
+	"/product.ProductService/GetProductCategories": func(req interface{}) []errs.FieldViolation {
+		return productId(req.(*pb.GetProductId))
+	},
+}
+
+// Validate checks req against the rules declared for method. Methods
+// without rules always pass.
+func Validate(method string, req interface{}) error {
+	rule, ok := rules[method]
+	if !ok {
+		return nil
+	}
+
+	if violations := rule(req); len(violations) > 0 {
+		return errs.InvalidFields(violations...)
+	}
+
+	return nil
+}
+
+// Field runs checks against value and reports the first failure under name
+func Field[T any](name string, value T, checks ...Check[T]) []errs.FieldViolation {
+	for _, check := range checks {
+		if description := check(value); description != "" {
+			return []errs.FieldViolation{{Field: name, Description: description}}
+		}
+	}
+
+	return nil
+}
+
+func collect(groups ...[]errs.FieldViolation) []errs.FieldViolation {
+	var violations []errs.FieldViolation
+	for _, group := range groups {
+		violations = append(violations, group...)
+	}
+
+	return violations
+}
+
+func product(r *pb.Product) []errs.FieldViolation {
+	return collect(
+		Field("name", r.Name, Required, MaxLength(maxNameLength)),
+		Field("description", r.Description, MaxLength(maxDescriptionLength)),
+		Field("price", r.Price, Positive[float32]),
+		Field("amount", r.Amount, NonNegative[int32]),
+	)
+}
+
+// replaceProduct checks a product UpdateProduct writes in full
+func replaceProduct(r *pb.Product) []errs.FieldViolation {
+	violations := collect(
+		Field("id", r.Id, Positive[int32]),
+		Field("version", r.Version, NonNegative[int64]),
+	)
+
+	return append(violations, product(r)...)
+}
+
+// updateProduct checks the mask and only the product fields it writes
+func updateProduct(r *pb.UpdateProductRequest) []errs.FieldViolation {
+	if r.Product == nil {
+		return []errs.FieldViolation{{Field: "product", Description: "must be set"}}
+	}
+
+	violations := collect(
+		Field("product.id", r.Product.Id, Positive[int32]),
+		Field("product.version", r.Product.Version, NonNegative[int64]),
+	)
+
+	paths := r.GetUpdateMask().GetPaths()
+	masked := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if !repo.IsUpdatableProductField(path) {
+			violations = append(violations, errs.FieldViolation{
+				Field:       "update_mask",
+				Description: fmt.Sprintf("unknown product field %q", path),
+			})
+		}
+		masked[path] = true
+	}
+
+	for _, v := range product(r.Product) {
+		if len(paths) == 0 || masked[v.Field] {
+			v.Field = "product." + v.Field
+			violations = append(violations, v)
+		}
+	}
+
+	return violations
+}
+
+func listProducts(r *pb.GetListRequest) []errs.FieldViolation {
+	violations := collect(
+		Field("page", r.Page, pageRule(r.PageToken)),
+		Field("limit", r.Limit, Between[int32](1, MaxPageSize)),
+		Field("category_id", r.CategoryId, NonNegative[int32]),
+		Field("name", r.Name, MaxLength(maxNameLength)),
+		Field("min_price", r.MinPrice, NonNegative[float32]),
+		Field("max_price", r.MaxPrice, NonNegative[float32]),
+		Field("created_after", r.CreatedAfter, Optional(Timestamp)),
+		Field("created_before", r.CreatedBefore, Optional(Timestamp)),
+		Field("sort_by", r.SortBy, Enum[pb.SortField](pb.SortField_name)),
+		priceBounds(r.PriceBounds),
+	)
+
+	if r.MinPrice > 0 && r.MaxPrice > 0 && r.MaxPrice < r.MinPrice {
+		violations = append(violations, errs.FieldViolation{Field: "max_price", Description: "must not be less than min_price"})
+	}
+
+	return violations
+}
+
+func priceBounds(bounds []float32) []errs.FieldViolation {
+	for i, bound := range bounds {
+		if bound <= 0 || (i > 0 && bound <= bounds[i-1]) {
+			return []errs.FieldViolation{{Field: "price_bounds", Description: "must be positive and ascending"}}
+		}
+	}
+
+	return nil
+}
+
+// pageRule checks page unless a page token, which replaces it, is given
+func pageRule(pageToken string) Check[int32] {
+	if pageToken != "" {
+		return NonNegative[int32]
+	}
+
+	return Positive[int32]
+}
+
+func productId(r *pb.GetProductId) []errs.FieldViolation {
+	return Field("product_id", r.ProductId, Positive[int32])
+}
+
+func productAmount(r *pb.ProductAmountRequest) []errs.FieldViolation {
+	return collect(
+		Field("product_id", r.ProductId, Positive[int32]),
+		Field("amount_by", r.AmountBy, Positive[int32]),
+		Field("expected_version", r.ExpectedVersion, NonNegative[int64]),
+	)
+}
+
+func category(r *pb.Category) []errs.FieldViolation {
+	return collect(
+		Field("parent_id", r.ParentId, NonNegative[int32]),
+		Field("name", r.Name, Required, MaxLength(maxNameLength)),
+		Field("slug", r.Slug, Required, MaxLength(maxSlugLength), Slug),
+		Field("position", r.Position, NonNegative[int32]),
+	)
+}
+
+func categoryId(r *pb.GetCategoryId) []errs.FieldViolation {
+	return Field("category_id", r.CategoryId, Positive[int32])
+}
+
+func reservationId(r *pb.GetReservationId) []errs.FieldViolation {
+	return Field("reservation_id", r.ReservationId, Positive[int32])
+}
@@ -0,0 +1,170 @@
+package validation
+
+import (
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		req    interface{}
+		fields []string
+	}{
+		{
+			name:   "valid product",
+			method: "/product.ProductService/CreateProduct",
+			req:    &pb.Product{Name: "Tea", Price: 1.5, Amount: 0},
+		},
+		{
+			name:   "empty name and negative price",
+			method: "/product.ProductService/CreateProduct",
+			req:    &pb.Product{Name: "  ", Price: -1, Amount: -2},
+			fields: []string{"name", "price", "amount"},
+		},
+		{
+			name:   "update needs an id",
+			method: "/product.ProductService/UpdateProduct",
+			req:    &pb.Product{Name: "Tea", Price: 1.5},
+			fields: []string{"id"},
+		},
+		{
+			name:   "update checks every field",
+			method: "/product.ProductService/UpdateProduct",
+			req:    &pb.Product{Id: 1, Price: 2},
+			fields: []string{"name"},
+		},
+		{
+			name:   "patch needs an id",
+			method: "/product.ProductService/PatchProduct",
+			req:    &pb.UpdateProductRequest{Product: &pb.Product{Name: "Tea", Price: 1.5}},
+			fields: []string{"product.id"},
+		},
+		{
+			name:   "patch checks masked fields only",
+			method: "/product.ProductService/PatchProduct",
+			req: &pb.UpdateProductRequest{
+				Product:    &pb.Product{Id: 1, Price: 2},
+				UpdateMask: &types.FieldMask{Paths: []string{"price"}},
+			},
+		},
+		{
+			name:   "patch rejects unknown paths",
+			method: "/product.ProductService/PatchProduct",
+			req: &pb.UpdateProductRequest{
+				Product:    &pb.Product{Id: 1, Price: -2},
+				UpdateMask: &types.FieldMask{Paths: []string{"price", "created_at"}},
+			},
+			fields: []string{"update_mask", "product.price"},
+		},
+		{
+			name:   "negative versions",
+			method: "/product.ProductService/IncreaseProductAmount",
+			req:    &pb.ProductAmountRequest{ProductId: 1, AmountBy: 1, ExpectedVersion: -1},
+			fields: []string{"expected_version"},
+		},
+		{
+			name:   "page zero and oversized limit",
+			method: "/product.ProductService/ListProducts",
+			req:    &pb.GetListRequest{Page: 0, Limit: MaxPageSize + 1},
+			fields: []string{"page", "limit"},
+		},
+		{
+			name:   "inverted price range and bad bounds",
+			method: "/product.ProductService/ListProducts",
+			req: &pb.GetListRequest{
+				Page: 1, Limit: 10, MinPrice: 10, MaxPrice: 5,
+				CreatedAfter: "yesterday", SortBy: pb.SortField(42),
+			},
+			fields: []string{"created_after", "sort_by", "max_price"},
+		},
+		{
+			name:   "search needs a query",
+			method: "/product.ProductService/SearchProducts",
+			req:    &pb.SearchProductsRequest{Query: " ", Limit: 10, PageToken: "abc", PriceBounds: []float32{10, 5}},
+			fields: []string{"query", "price_bounds"},
+		},
+		{
+			name:   "negative amount_by",
+			method: "/product.ProductService/DecreaseProductAmount",
+			req:    &pb.ProductAmountRequest{ProductId: 1, AmountBy: -5},
+			fields: []string{"amount_by"},
+		},
+		{
+			name:   "malformed user id",
+			method: "/product.ProductService/BuyProduct",
+			req:    &pb.BuyProductRequest{UserId: "42", ProductId: 1, Amount: 1},
+			fields: []string{"user_id"},
+		},
+		{
+			name:   "valid purchase",
+			method: "/product.ProductService/BuyProduct",
+			req:    &pb.BuyProductRequest{UserId: uuid.New().String(), ProductId: 1, Amount: 1},
+		},
+		{
+			name:   "order lines are checked one by one",
+			method: "/product.ProductService/CreateOrder",
+			req: &pb.CreateOrderRequest{
+				UserId: uuid.New().String(),
+				Items:  []*pb.OrderLine{{ProductId: 1, Quantity: 2}, {ProductId: 0, Quantity: -1}},
+			},
+			fields: []string{"items[1].product_id", "items[1].quantity"},
+		},
+		{
+			name:   "category slug must be url safe",
+			method: "/product.ProductService/CreateCategory",
+			req:    &pb.Category{Name: "Home", Slug: "Home Appliances"},
+			fields: []string{"slug"},
+		},
+		{
+			name:   "valid category",
+			method: "/product.ProductService/CreateCategory",
+			req:    &pb.Category{Name: "Home", Slug: "home-appliances", ParentId: 3},
+		},
+		{
+			name:   "methods without rules pass",
+			method: "/product.ProductService/Unknown",
+			req:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.method, tc.req)
+			if tc.fields == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, errs.ErrInvalidArgument)
+			assert.Equal(t, tc.fields, violatedFields(t, err))
+		})
+	}
+}
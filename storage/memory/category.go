@@ -0,0 +1,223 @@
+package memory
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"sort"
+)
+
+func (m *productRepo) CreateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkCategory(0, req); err != nil {
+		return nil, err
+	}
+
+	m.lastCategoryID++
+	category := &pb.Category{
+		Id:        m.lastCategoryID,
+		ParentId:  req.ParentId,
+		Name:      req.Name,
+		Slug:      req.Slug,
+		Position:  req.Position,
+		CreatedAt: now(),
+	}
+	m.categories[category.Id] = category
+
+	return cloneCategory(category), nil
+}
+
+func (m *productRepo) GetCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Category, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	category, ok := m.categories[req.CategoryId]
+	if !ok {
+		return nil, errs.NotFound("category", req.CategoryId)
+	}
+
+	return cloneCategory(category), nil
+}
+
+func (m *productRepo) UpdateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	category, ok := m.categories[req.Id]
+	if !ok {
+		return nil, errs.NotFound("category", req.Id)
+	}
+
+	if err := m.checkCategory(req.Id, req); err != nil {
+		return nil, err
+	}
+
+	category.ParentId = req.ParentId
+	category.Name = req.Name
+	category.Slug = req.Slug
+	category.Position = req.Position
+	category.UpdatedAt = now()
+
+	return cloneCategory(category), nil
+}
+
+// DeleteCategory refuses categories with subcategories and unassigns the
+// products of the deleted one
+func (m *productRepo) DeleteCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Status, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.categories[req.CategoryId]; !ok {
+		return &pb.Status{Success: false}, errs.NotFound("category", req.CategoryId)
+	}
+
+	for _, category := range m.categories {
+		if category.ParentId == req.CategoryId {
+			return &pb.Status{Success: false}, errs.FailedPrecondition("CATEGORY_HAS_CHILDREN", "category %d has subcategories", req.CategoryId)
+		}
+	}
+
+	delete(m.categories, req.CategoryId)
+	for _, assigned := range m.productCategories {
+		delete(assigned, req.CategoryId)
+	}
+
+	return &pb.Status{Success: true}, nil
+}
+
+func (m *productRepo) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.categories[req.ParentId]; req.ParentId != 0 && !ok {
+		return nil, errs.NotFound("category", req.ParentId)
+	}
+
+	var included map[int32]bool
+	if req.Recursive {
+		included = m.subtree(req.ParentId)
+		delete(included, req.ParentId)
+	}
+
+	response := &pb.ListCategoriesResponse{}
+	for _, category := range m.categories {
+		if (req.Recursive && included[category.Id]) || (!req.Recursive && category.ParentId == req.ParentId) {
+			response.Categories = append(response.Categories, cloneCategory(category))
+		}
+	}
+	sortCategories(response.Categories)
+
+	return response, nil
+}
+
+// SetProductCategories replaces the categories of a product
+func (m *productRepo) SetProductCategories(ctx context.Context, req *pb.ProductCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.live(req.ProductId); !ok {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+
+	assigned := make(map[int32]bool, len(req.CategoryIds))
+	for _, id := range req.CategoryIds {
+		if _, ok := m.categories[id]; !ok {
+			return nil, errs.NotFound("category", id)
+		}
+		assigned[id] = true
+	}
+	m.productCategories[req.ProductId] = assigned
+
+	return m.categoriesOf(req.ProductId), nil
+}
+
+func (m *productRepo) GetProductCategories(ctx context.Context, req *pb.GetProductId) (*pb.ListCategoriesResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	product, ok := m.products[req.ProductId]
+	if !ok || (product.Deleted != "" && !req.IncludeDeleted) {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+
+	return m.categoriesOf(req.ProductId), nil
+}
+
+// checkCategory validates the parent and slug of category, which has id
+// or is new when id is 0
+func (m *productRepo) checkCategory(id int32, category *pb.Category) error {
+	if category.ParentId != 0 {
+		if _, ok := m.categories[category.ParentId]; !ok {
+			return errs.NotFound("category", category.ParentId)
+		}
+		if id != 0 && m.subtree(id)[category.ParentId] {
+			return errs.InvalidArgument("category %d cannot be moved under itself or its descendant %d", id, category.ParentId)
+		}
+	}
+
+	for _, other := range m.categories {
+		if other.Id != id && other.Slug == category.Slug {
+			return errs.AlreadyExists("category", category.Slug)
+		}
+	}
+
+	return nil
+}
+
+// subtree returns id and the ids of all its descendants; 0 means every category
+func (m *productRepo) subtree(id int32) map[int32]bool {
+	tree := map[int32]bool{id: true}
+	for grown := true; grown; {
+		grown = false
+		for _, category := range m.categories {
+			if tree[category.ParentId] && !tree[category.Id] {
+				tree[category.Id] = true
+				grown = true
+			}
+		}
+	}
+
+	return tree
+}
+
+// inCategory reports whether productId is assigned anywhere in tree
+func (m *productRepo) inCategory(productId int32, tree map[int32]bool) bool {
+	for id := range m.productCategories[productId] {
+		if tree[id] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *productRepo) categoriesOf(productId int32) *pb.ListCategoriesResponse {
+	response := &pb.ListCategoriesResponse{}
+	for id := range m.productCategories[productId] {
+		response.Categories = append(response.Categories, cloneCategory(m.categories[id]))
+	}
+	sortCategories(response.Categories)
+
+	return response
+}
+
+func cloneCategory(category *pb.Category) *pb.Category {
+	cp := *category
+	return &cp
+}
+
+// sortCategories orders by parent, position and id, like the SQL backend
+func sortCategories(categories []*pb.Category) {
+	sort.Slice(categories, func(i, j int) bool {
+		a, b := categories[i], categories[j]
+		if a.ParentId != b.ParentId {
+			return a.ParentId < b.ParentId
+		}
+		if a.Position != b.Position {
+			return a.Position < b.Position
+		}
+		return a.Id < b.Id
+	})
+}
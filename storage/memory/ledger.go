@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+	"sort"
+	"time"
+)
+
+func (m *productRepo) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	filter, err := repo.NewMovementFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var matched []*pb.StockMovement
+	for _, movement := range m.movements {
+		if movement.ProductId != filter.ProductId {
+			continue
+		}
+		createdAt, _ := time.Parse(time.RFC3339Nano, movement.CreatedAt)
+		if filter.Contains(createdAt) {
+			matched = append(matched, movement)
+		}
+	}
+
+	response := &pb.ListStockMovementsResponse{TotalCount: int64(len(matched))}
+	for i := filter.Offset; i < int64(len(matched)) && i < filter.Offset+int64(filter.Limit); i++ {
+		cp := *matched[i]
+		response.Movements = append(response.Movements, &cp)
+	}
+
+	return response, nil
+}
+
+func (m *productRepo) ReconcileStock(ctx context.Context, req *pb.ReconcileStockRequest) (*pb.ReconcileStockResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ledger := make(map[int32]int32)
+	for _, movement := range m.movements {
+		ledger[movement.ProductId] += movement.Delta
+	}
+
+	response := &pb.ReconcileStockResponse{}
+	for id, product := range m.products {
+		if req.ProductId != 0 && id != req.ProductId {
+			continue
+		}
+		response.Checked++
+		if ledger[id] != product.Amount {
+			response.Discrepancies = append(response.Discrepancies, &pb.StockDiscrepancy{
+				ProductId:    id,
+				Amount:       product.Amount,
+				LedgerAmount: ledger[id],
+			})
+		}
+	}
+	sort.Slice(response.Discrepancies, func(i, j int) bool {
+		return response.Discrepancies[i].ProductId < response.Discrepancies[j].ProductId
+	})
+
+	return response, nil
+}
+
+// record appends a ledger entry for a change of delta to product, which
+// already holds the resulting amount. The caller holds the write lock.
+func (m *productRepo) record(ctx context.Context, product *pb.Product, delta int32, reason pb.StockMovementReason, reference string) {
+	if delta == 0 {
+		return
+	}
+
+	movement := repo.NewMovement(ctx, product.Id, delta, product.Amount, reason, reference)
+	m.lastMovementID++
+	movement.Id = m.lastMovementID
+	movement.CreatedAt = now()
+	m.movements = append(m.movements, movement)
+}
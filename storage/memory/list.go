@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"cmp"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+	"strings"
+	"time"
+)
+
+// matches applies the filters of a list request to product; the caller
+// holds the lock
+func (m *productRepo) matches(product *pb.Product, filter *repo.ListFilter) bool {
+	if filter.Name != "" && !strings.Contains(strings.ToLower(product.Name), strings.ToLower(filter.Name)) {
+		return false
+	}
+	if filter.MinPrice > 0 && product.Price < filter.MinPrice {
+		return false
+	}
+	if filter.MaxPrice > 0 && product.Price > filter.MaxPrice {
+		return false
+	}
+	if filter.InStock && m.available(product) == 0 {
+		return false
+	}
+
+	createdAt := parseTime(product.CreatedAt)
+	if !filter.CreatedAfter.IsZero() && createdAt.Before(filter.CreatedAfter) {
+		return false
+	}
+	if !filter.CreatedBefore.IsZero() && !createdAt.Before(filter.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// less orders a before b by the sort column, then by id, in the requested direction
+func less(a, b *pb.Product, filter *repo.ListFilter) bool {
+	order := compare(a, b, filter.SortBy)
+	if order == 0 {
+		order = cmp.Compare(a.Id, b.Id)
+	}
+	if filter.Descending {
+		return order > 0
+	}
+
+	return order < 0
+}
+
+func compare(a, b *pb.Product, field pb.SortField) int {
+	switch field {
+	case pb.SortField_SORT_PRICE:
+		return cmp.Compare(a.Price, b.Price)
+	case pb.SortField_SORT_NAME:
+		return strings.Compare(a.Name, b.Name)
+	case pb.SortField_SORT_CREATED_AT:
+		return parseTime(a.CreatedAt).Compare(parseTime(b.CreatedAt))
+	case pb.SortField_SORT_AMOUNT:
+		return cmp.Compare(a.Amount, b.Amount)
+	default:
+		return 0
+	}
+}
+
+// facets counts the facets of the products with ids
+func (m *productRepo) facets(spec *repo.FacetSpec, ids []int32) *pb.Facets {
+	counter := spec.Counter()
+	for _, id := range ids {
+		counter.Add(m.products[id], m.available(m.products[id]), m.categoriesOf(id).Categories)
+	}
+
+	return counter.Facets()
+}
+
+// cursorProduct builds a stand-in for the last product of the previous page
+// that less can compare against
+func cursorProduct(cursor *repo.Cursor, field pb.SortField) *pb.Product {
+	product := &pb.Product{Id: cursor.Id}
+	switch field {
+	case pb.SortField_SORT_PRICE:
+		product.Price = cursor.Key.(float32)
+	case pb.SortField_SORT_NAME:
+		product.Name = cursor.Key.(string)
+	case pb.SortField_SORT_CREATED_AT:
+		product.CreatedAt = cursor.Key.(time.Time).Format(time.RFC3339Nano)
+	case pb.SortField_SORT_AMOUNT:
+		product.Amount = cursor.Key.(int32)
+	}
+
+	return product
+}
+
+// parseTime reads a timestamp written by now
+func parseTime(value string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, value)
+	return t
+}
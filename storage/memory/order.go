@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/storage/repo"
+	"sort"
+)
+
+func (m *productRepo) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.Order, error) {
+	lines, err := repo.MergeOrderLines(req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	order, _, err := m.placeOrder(ctx, req.UserId, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloneOrder(order), nil
+}
+
+func (m *productRepo) GetOrder(ctx context.Context, req *pb.GetOrderId) (*pb.Order, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	order, ok := m.orders[req.OrderId]
+	if !ok {
+		return nil, errs.NotFound("order", req.OrderId)
+	}
+
+	return cloneOrder(order), nil
+}
+
+func (m *productRepo) ListOrdersByUser(ctx context.Context, req *pb.ListOrdersByUserRequest) (*pb.ListOrdersResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var ids []int32
+	for id, order := range m.orders {
+		if order.UserId == req.UserId {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+
+	response := &pb.ListOrdersResponse{TotalCount: int64(len(ids))}
+	for _, id := range paginate(ids, int64(req.Page-1)*int64(req.Limit), req.Limit) {
+		response.Orders = append(response.Orders, cloneOrder(m.orders[id]))
+	}
+
+	return response, nil
+}
+
+// TransitionOrder moves an order to req.Status; cancelling puts its stock back
+func (m *productRepo) TransitionOrder(ctx context.Context, req *pb.TransitionOrderRequest) (*pb.Order, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	order, ok := m.orders[req.OrderId]
+	if !ok {
+		return nil, errs.NotFound("order", req.OrderId)
+	}
+	if err := repo.CheckTransition(order.Id, order.Status, req.Status); err != nil {
+		return nil, err
+	}
+
+	order.Status = req.Status
+	repo.SetStatusTime(order, req.Status, now())
+
+	if req.Status == pb.OrderStatus_ORDER_CANCELLED {
+		// returned units were restocked, or not, by the return itself
+		for _, line := range m.purchases {
+			if line.OrderId != order.Id || line.NetQuantity == 0 {
+				continue
+			}
+			m.restock(ctx, line.ProductId, line.NetQuantity, pb.StockMovementReason_MOVEMENT_CANCELLATION, repo.OrderRef(order.Id))
+		}
+	}
+
+	return cloneOrder(order), nil
+}
+
+// restock puts quantity back in stock and records it in the ledger. Unlike
+// increase it also restocks soft-deleted products, which orders may still
+// hold. The caller holds the write lock.
+func (m *productRepo) restock(ctx context.Context, productId, quantity int32, reason pb.StockMovementReason, ref string) {
+	product := m.products[productId]
+	product.Amount += quantity
+	touch(product)
+	m.record(ctx, product, quantity, reason, ref)
+}
+
+// ReturnOrderItem records a return against an order line and restocks it if asked
+func (m *productRepo) ReturnOrderItem(ctx context.Context, req *pb.ReturnOrderItemRequest) (*pb.OrderReturn, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	order, ok := m.orders[req.OrderId]
+	if !ok {
+		return nil, errs.NotFound("order", req.OrderId)
+	}
+	if err := repo.CheckReturnable(order.Id, order.Status); err != nil {
+		return nil, err
+	}
+
+	var line *pb.Purchase
+	for _, purchase := range m.purchases {
+		if purchase.OrderId == req.OrderId && purchase.ProductId == req.ProductId {
+			line = purchase
+			break
+		}
+	}
+	if line == nil {
+		return nil, repo.OrderItemNotFound(req.OrderId, req.ProductId)
+	}
+	if err := repo.CheckReturnQuantity(line, req.Quantity); err != nil {
+		return nil, err
+	}
+
+	if req.Restock {
+		m.restock(ctx, req.ProductId, req.Quantity, pb.StockMovementReason_MOVEMENT_RETURN, repo.OrderRef(req.OrderId))
+	}
+
+	line.ReturnedQuantity += req.Quantity
+	line.NetQuantity -= req.Quantity
+
+	m.lastReturnID++
+	orderReturn := repo.NewOrderReturn(req, line)
+	orderReturn.Id = m.lastReturnID
+	orderReturn.CreatedAt = now()
+	m.returns = append(m.returns, orderReturn)
+
+	cp := *orderReturn
+	return &cp, nil
+}
+
+// placeOrder checks every line before taking any stock, so a failed order
+// changes nothing. The caller holds the write lock.
+func (m *productRepo) placeOrder(ctx context.Context, userId string, lines []*pb.OrderLine) (*pb.Order, []*pb.Product, error) {
+	for _, line := range lines {
+		product, ok := m.live(line.ProductId)
+		if !ok {
+			return nil, nil, errs.NotFound("product", line.ProductId)
+		}
+		if available := m.available(product); available < line.Quantity {
+			return nil, nil, errs.InsufficientStock(product.Id, available, line.Quantity)
+		}
+	}
+
+	m.lastOrderID++
+	order := &pb.Order{Id: m.lastOrderID, UserId: userId, CreatedAt: now()}
+
+	products := make([]*pb.Product, 0, len(lines))
+	for _, line := range lines {
+		product := m.products[line.ProductId]
+		product.Amount -= line.Quantity
+		touch(product)
+		m.record(ctx, product, -line.Quantity, pb.StockMovementReason_MOVEMENT_SALE, repo.OrderRef(order.Id))
+
+		order.Items = append(order.Items, &pb.OrderItem{
+			ProductId: line.ProductId,
+			Quantity:  line.Quantity,
+			UnitPrice: product.Price,
+		})
+		m.purchases = append(m.purchases, &pb.Purchase{
+			OrderId:     order.Id,
+			ProductId:   line.ProductId,
+			Quantity:    line.Quantity,
+			NetQuantity: line.Quantity,
+			UnitPrice:   product.Price,
+			CreatedAt:   order.CreatedAt,
+		})
+		products = append(products, clone(product))
+	}
+	repo.SetOrderTotals(order)
+	m.orders[order.Id] = order
+
+	return order, products, nil
+}
+
+func cloneOrder(order *pb.Order) *pb.Order {
+	cp := *order
+	cp.Items = make([]*pb.OrderItem, len(order.Items))
+	for i, item := range order.Items {
+		itemCp := *item
+		cp.Items[i] = &itemCp
+	}
+
+	return &cp
+}
@@ -0,0 +1,404 @@
+package memory
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/pkg/logger"
+	"exam/product-service/storage/repo"
+	"sort"
+	"sync"
+	"time"
+)
+
+type productRepo struct {
+	mu                sync.RWMutex
+	lastID            int32
+	products          map[int32]*pb.Product
+	purchases         []*pb.Purchase
+	lastCategoryID    int32
+	categories        map[int32]*pb.Category
+	productCategories map[int32]map[int32]bool
+	lastOrderID       int32
+	orders            map[int32]*pb.Order
+	lastReturnID      int32
+	returns           []*pb.OrderReturn
+	lastReservationID int32
+	reservations      map[int32]*reservation
+	reserved          map[int32]int32 // quantity held by active reservations, per product
+	lastMovementID    int64
+	movements         []*pb.StockMovement
+	log               logger.Logger
+}
+
+// Constructor
+func NewProductRepo(log logger.Logger) repo.ProductServiceI {
+	return &productRepo{
+		products:          make(map[int32]*pb.Product),
+		categories:        make(map[int32]*pb.Category),
+		productCategories: make(map[int32]map[int32]bool),
+		orders:            make(map[int32]*pb.Order),
+		reservations:      make(map[int32]*reservation),
+		reserved:          make(map[int32]int32),
+		log:               log,
+	}
+}
+
+func (m *productRepo) CreateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lastID++
+	req.Id = m.lastID
+	req.CreatedAt = now()
+	req.UpdatedAt = ""
+	req.Version = 1
+	m.products[req.Id] = clone(req)
+	m.record(ctx, req, req.Amount, pb.StockMovementReason_MOVEMENT_OPENING, "")
+
+	return req, nil
+}
+
+func (m *productRepo) GetProductById(ctx context.Context, req *pb.GetProductId) (*pb.Product, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	product, ok := m.products[req.ProductId]
+	if !ok || (product.Deleted != "" && !req.IncludeDeleted) {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+
+	return clone(product), nil
+}
+
+func (m *productRepo) GetProductsByIds(ctx context.Context, req *pb.GetProductsByIdsRequest) (*pb.GetProductsByIdsResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.byIds(req.ProductIds, req.IncludeDeleted), nil
+}
+
+// UpdateProduct writes only the fields named in the update mask. A non-zero
+// product version must match the stored one.
+func (m *productRepo) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	paths, err := repo.UpdateMaskPaths(req)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	product, err := m.current(req.Product.Id, req.Product.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	before := product.Amount
+	for _, path := range paths {
+		switch path {
+		case "name":
+			product.Name = req.Product.Name
+		case "description":
+			product.Description = req.Product.Description
+		case "price":
+			product.Price = req.Product.Price
+		case "amount":
+			product.Amount = req.Product.Amount
+		}
+	}
+	touch(product)
+	m.record(ctx, product, product.Amount-before, pb.StockMovementReason_MOVEMENT_ADJUSTMENT, "")
+
+	return clone(product), nil
+}
+
+func (m *productRepo) DeleteProduct(ctx context.Context, req *pb.GetProductId) (*pb.Status, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	product, ok := m.live(req.ProductId)
+	if !ok {
+		return &pb.Status{Success: false}, errs.NotFound("product", req.ProductId)
+	}
+	product.Deleted = now()
+	product.Version++
+
+	return &pb.Status{Success: true}, nil
+}
+
+func (m *productRepo) ListProducts(ctx context.Context, req *pb.GetListRequest) (*pb.GetListResponse, error) {
+	filter, err := repo.NewListFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var tree map[int32]bool
+	if req.CategoryId != 0 {
+		tree = m.subtree(req.CategoryId)
+	}
+
+	ids := make([]int32, 0, len(m.products))
+	for id, product := range m.products {
+		if product.Deleted != "" && !req.IncludeDeleted {
+			continue
+		}
+		if tree != nil && !m.inCategory(id, tree) {
+			continue
+		}
+		if !m.matches(product, filter) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return less(m.products[ids[i]], m.products[ids[j]], filter) })
+
+	matched := ids
+	if filter.After != nil {
+		pivot := cursorProduct(filter.After, filter.SortBy)
+		ids = ids[sort.Search(len(ids), func(i int) bool { return less(pivot, m.products[ids[i]], filter) }):]
+	}
+
+	// one extra product tells whether there is a next page
+	var products []*pb.Product
+	for _, id := range paginate(ids, filter.Offset, filter.Limit+1) {
+		products = append(products, clone(m.products[id]))
+	}
+
+	response := &pb.GetListResponse{}
+	filter.Fill(response, products, int64(len(matched)))
+	if filter.Facets != nil {
+		response.Facets = m.facets(filter.Facets, matched)
+	}
+
+	return response, nil
+}
+
+func (m *productRepo) IncreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	product, err := m.increase(req)
+	if err != nil {
+		return nil, err
+	}
+	m.record(ctx, product, req.AmountBy, pb.StockMovementReason_MOVEMENT_INCREASE, "")
+
+	return &pb.ProductAmountResponse{IsEnough: true, Product: clone(product)}, nil
+}
+
+// increase adds stock to a live product. The caller holds the write lock.
+func (m *productRepo) increase(req *pb.ProductAmountRequest) (*pb.Product, error) {
+	product, err := m.current(req.ProductId, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	product.Amount += req.AmountBy
+	touch(product)
+
+	return product, nil
+}
+
+func (m *productRepo) DecreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	product, err := m.current(req.ProductId, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if available := m.available(product); available < req.AmountBy {
+		return &pb.ProductAmountResponse{IsEnough: false, Product: clone(product)}, errs.InsufficientStock(product.Id, available, req.AmountBy)
+	}
+
+	product.Amount -= req.AmountBy
+	touch(product)
+	m.record(ctx, product, -req.AmountBy, pb.StockMovementReason_MOVEMENT_DECREASE, "")
+
+	return &pb.ProductAmountResponse{IsEnough: true, Product: clone(product)}, nil
+}
+
+func (m *productRepo) CheckAmount(ctx context.Context, req *pb.GetProductId) (*pb.CheckAmountResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	product, ok := m.live(req.ProductId)
+	if !ok {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+
+	return &pb.CheckAmountResponse{
+		ProductId: product.Id,
+		Amount:    m.available(product),
+		Reserved:  m.reserved[product.Id],
+	}, nil
+}
+
+// BuyProduct places an order with a single line and returns the product
+func (m *productRepo) BuyProduct(ctx context.Context, req *pb.BuyProductRequest) (*pb.Product, error) {
+	lines, err := repo.MergeOrderLines([]*pb.OrderLine{{ProductId: req.ProductId, Quantity: req.Amount}})
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, products, err := m.placeOrder(ctx, req.UserId, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	return products[0], nil
+}
+
+func (m *productRepo) GetPurchasedProductsByUserId(ctx context.Context, req *pb.GetUserID) (*pb.GetPurchasedProductsResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	response := &pb.GetPurchasedProductsResponse{}
+	var ids []int32
+	for _, purchase := range m.purchases {
+		if m.orders[purchase.OrderId].UserId == req.UserId {
+			ids = append(ids, purchase.ProductId)
+			cp := *purchase
+			response.Purchases = append(response.Purchases, &cp)
+		}
+	}
+
+	products := m.byIds(ids, true)
+	if len(products.MissingIds) > 0 {
+		return nil, errs.NotFound("product", products.MissingIds[0])
+	}
+	response.Products = products.Products
+
+	return response, nil
+}
+
+// RestoreProduct clears the deletion mark; restoring a live product is a no-op
+func (m *productRepo) RestoreProduct(ctx context.Context, req *pb.GetProductId) (*pb.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	product, ok := m.products[req.ProductId]
+	if !ok {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+	product.Deleted = ""
+	product.Version++
+
+	return clone(product), nil
+}
+
+func (m *productRepo) PurgeDeletedProducts(ctx context.Context, req *pb.PurgeDeletedProductsRequest) (*pb.PurgeDeletedProductsResponse, error) {
+	olderThan, err := time.Parse(time.RFC3339, req.OlderThan)
+	if err != nil {
+		return nil, errs.InvalidArgument("older_than: %v", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// purchase history must outlive the purge
+	purchased := make(map[int32]bool)
+	for _, purchase := range m.purchases {
+		purchased[purchase.ProductId] = true
+	}
+
+	response := &pb.PurgeDeletedProductsResponse{}
+	for id, product := range m.products {
+		if product.Deleted == "" || purchased[id] {
+			continue
+		}
+
+		deletedAt, err := time.Parse(time.RFC3339Nano, product.Deleted)
+		if err != nil {
+			return nil, err
+		}
+
+		if deletedAt.Before(olderThan) {
+			delete(m.products, id)
+			delete(m.productCategories, id)
+			response.Purged++
+		}
+	}
+
+	return response, nil
+}
+
+// live returns the product unless it is missing or soft-deleted
+func (m *productRepo) live(id int32) (*pb.Product, bool) {
+	product, ok := m.products[id]
+	if !ok || product.Deleted != "" {
+		return nil, false
+	}
+
+	return product, true
+}
+
+// available is the stock of product not held by reservations
+func (m *productRepo) available(product *pb.Product) int32 {
+	return repo.Available(product.Amount, m.reserved[product.Id])
+}
+
+// byIds looks up products for GetProductsByIds; the caller holds the lock
+func (m *productRepo) byIds(ids []int32, includeDeleted bool) *pb.GetProductsByIdsResponse {
+	found := make(map[int32]*pb.Product, len(ids))
+	for _, id := range ids {
+		product, ok := m.products[id]
+		if ok && (product.Deleted == "" || includeDeleted) {
+			found[id] = clone(product)
+		}
+	}
+
+	return repo.InRequestOrder(ids, found)
+}
+
+// current returns the live product, checking expectedVersion unless it is zero
+func (m *productRepo) current(id int32, expectedVersion int64) (*pb.Product, error) {
+	product, ok := m.live(id)
+	if !ok {
+		return nil, errs.NotFound("product", id)
+	}
+
+	if expectedVersion != 0 && product.Version != expectedVersion {
+		return nil, errs.VersionMismatch("product", id, expectedVersion, product.Version)
+	}
+
+	return product, nil
+}
+
+// touch records a write to product
+func touch(product *pb.Product) {
+	product.UpdatedAt = now()
+	product.Version++
+}
+
+// now formats the current time the way lib/pq renders timestamp columns
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+func clone(product *pb.Product) *pb.Product {
+	cp := *product
+	return &cp
+}
+
+// paginate applies an OFFSET and LIMIT the way the SQL backend does
+func paginate(ids []int32, offset int64, limit int32) []int32 {
+	if offset < 0 || limit < 1 || offset >= int64(len(ids)) {
+		return nil
+	}
+
+	end := offset + int64(limit)
+	if end > int64(len(ids)) {
+		end = int64(len(ids))
+	}
+
+	return ids[offset:end]
+}
@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"exam/product-service/pkg/logger"
+	"exam/product-service/storage/repo"
+	"exam/product-service/storage/repotest"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	repotest.Run(t, func(*testing.T) (repo.ProductServiceI, func()) {
+		return NewProductRepo(logger.New("", "")), func() {}
+	})
+}
@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/storage/repo"
+	"time"
+)
+
+// reservation keeps the expiry of a stock reservation as a time for the sweeper
+type reservation struct {
+	*pb.Reservation
+	expiresAt time.Time
+}
+
+func (m *productRepo) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.Reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	product, ok := m.live(req.ProductId)
+	if !ok {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+	if available := m.available(product); available < req.Quantity {
+		return nil, errs.InsufficientStock(product.Id, available, req.Quantity)
+	}
+
+	createdAt := time.Now().UTC()
+	expiresAt := repo.ReservationExpiry(req, createdAt)
+
+	m.lastReservationID++
+	r := &reservation{
+		Reservation: &pb.Reservation{
+			Id:        m.lastReservationID,
+			ProductId: req.ProductId,
+			Quantity:  req.Quantity,
+			Status:    pb.ReservationStatus_RESERVATION_ACTIVE,
+			ExpiresAt: expiresAt.Format(time.RFC3339Nano),
+			CreatedAt: createdAt.Format(time.RFC3339Nano),
+		},
+		expiresAt: expiresAt,
+	}
+	m.reservations[r.Id] = r
+	m.reserved[req.ProductId] += req.Quantity
+
+	return cloneReservation(r), nil
+}
+
+func (m *productRepo) CommitReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error) {
+	return m.settle(ctx, req.ReservationId, pb.ReservationStatus_RESERVATION_COMMITTED)
+}
+
+func (m *productRepo) ReleaseReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error) {
+	return m.settle(ctx, req.ReservationId, pb.ReservationStatus_RESERVATION_RELEASED)
+}
+
+func (m *productRepo) ReleaseExpiredReservations(ctx context.Context, now time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var released int64
+	for _, r := range m.reservations {
+		if r.Status == pb.ReservationStatus_RESERVATION_ACTIVE && r.expiresAt.Before(now) {
+			m.unreserve(r, pb.ReservationStatus_RESERVATION_EXPIRED)
+			released++
+		}
+	}
+
+	return released, nil
+}
+
+// settle ends an active reservation; committing takes its quantity from stock
+func (m *productRepo) settle(ctx context.Context, id int32, status pb.ReservationStatus) (*pb.Reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r, ok := m.reservations[id]
+	if !ok {
+		return nil, errs.NotFound("reservation", id)
+	}
+	if err := repo.CheckSettle(id, r.Status, r.expiresAt, time.Now(), status); err != nil {
+		return nil, err
+	}
+
+	if status != pb.ReservationStatus_RESERVATION_COMMITTED {
+		m.unreserve(r, status)
+		return cloneReservation(r), nil
+	}
+
+	// the hold outlives soft deletion, so the stock is taken regardless
+	product, ok := m.products[r.ProductId]
+	if !ok {
+		return nil, errs.NotFound("product", r.ProductId)
+	}
+	// the amount may have been set below what is reserved since
+	if product.Amount < r.Quantity {
+		return nil, errs.InsufficientStock(product.Id, product.Amount, r.Quantity)
+	}
+
+	m.unreserve(r, status)
+	product.Amount -= r.Quantity
+	touch(product)
+	m.record(ctx, product, -r.Quantity, pb.StockMovementReason_MOVEMENT_RESERVATION, repo.ReservationRef(r.Id))
+
+	return cloneReservation(r), nil
+}
+
+// unreserve gives the quantity held by r back and sets its final status
+func (m *productRepo) unreserve(r *reservation, status pb.ReservationStatus) {
+	r.Status = status
+	m.reserved[r.ProductId] -= r.Quantity
+	if m.reserved[r.ProductId] == 0 {
+		delete(m.reserved, r.ProductId)
+	}
+}
+
+func cloneReservation(r *reservation) *pb.Reservation {
+	cp := *r.Reservation
+	return &cp
+}
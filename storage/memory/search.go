@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+	"sort"
+	"strings"
+)
+
+// SearchProducts scores every live product with the shared matcher; it
+// ignores phrases and OR, which only the database engines understand
+func (m *productRepo) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	page, err := repo.NewSearchPage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var tree map[int32]bool
+	if req.CategoryId != 0 {
+		tree = m.subtree(req.CategoryId)
+	}
+
+	var hits []*pb.SearchHit
+	for id, product := range m.products {
+		if product.Deleted != "" || (tree != nil && !m.inCategory(id, tree)) {
+			continue
+		}
+		if score := page.Query.Score(product.Name, product.Description); score > 0 {
+			hits = append(hits, &pb.SearchHit{Product: product, Score: float32(score)})
+		}
+	}
+	repo.SortHits(hits)
+
+	ids := make([]int32, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.Product.Id
+	}
+
+	var paged []*pb.SearchHit
+	for i := range paginate(ids, page.Offset, page.Limit+1) {
+		hit := hits[int(page.Offset)+i]
+		paged = append(paged, &pb.SearchHit{Product: clone(hit.Product), Score: hit.Score})
+	}
+
+	response := &pb.SearchProductsResponse{}
+	page.Fill(response, paged, int64(len(hits)))
+	if page.Facets != nil {
+		response.Facets = m.facets(page.Facets, ids)
+	}
+
+	return response, nil
+}
+
+func (m *productRepo) SuggestProducts(ctx context.Context, req *pb.SuggestProductsRequest) (*pb.SuggestProductsResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	prefix := strings.ToLower(req.Prefix)
+
+	var suggestions []*pb.Suggestion
+	for _, product := range m.products {
+		if product.Deleted != "" || (req.InStock && m.available(product) == 0) {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(product.Name), prefix) {
+			suggestions = append(suggestions, &pb.Suggestion{ProductId: product.Id, Name: product.Name})
+		}
+	}
+
+	// byte order of the lowercase names, like the "C" collation in Postgres
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := strings.ToLower(suggestions[i].Name), strings.ToLower(suggestions[j].Name)
+		if a != b {
+			return a < b
+		}
+		return suggestions[i].ProductId < suggestions[j].ProductId
+	})
+	if len(suggestions) > int(req.Limit) {
+		suggestions = suggestions[:req.Limit]
+	}
+
+	return &pb.SuggestProductsResponse{Suggestions: suggestions}, nil
+}
@@ -0,0 +1,297 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// categorySort is shared by every category listing
+var categorySort = bson.D{{Key: "parent_id", Value: 1}, {Key: "position", Value: 1}, {Key: "id", Value: 1}}
+
+func (p *productRepo) CreateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	if err := p.checkParent(ctx, 0, req.ParentId); err != nil {
+		return nil, err
+	}
+
+	id, err := nextID(ctx, p.database, "categories")
+	if err != nil {
+		return nil, err
+	}
+
+	doc := categoryDoc{
+		Id:        id,
+		ParentId:  req.ParentId,
+		Name:      req.Name,
+		Slug:      req.Slug,
+		Position:  req.Position,
+		CreatedAt: time.Now().Truncate(time.Millisecond),
+	}
+
+	_, err = p.database.Collection("categories").InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, errs.AlreadyExists("category", req.Slug)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.toProto(), nil
+}
+
+func (p *productRepo) GetCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Category, error) {
+	var response categoryDoc
+	err := p.database.Collection("categories").FindOne(ctx, bson.M{"id": req.CategoryId}).Decode(&response)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound("category", req.CategoryId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response.toProto(), nil
+}
+
+// UpdateCategory checks and moves the category in one transaction. Moves
+// under a parent all write the same counter, so concurrent moves conflict
+// and the retry checks for a cycle again.
+func (p *productRepo) UpdateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	if _, err := p.GetCategory(ctx, &pb.GetCategoryId{CategoryId: req.Id}); err != nil {
+		return nil, err
+	}
+
+	updateReq := bson.M{"$set": bson.M{
+		"name":       req.Name,
+		"slug":       req.Slug,
+		"position":   req.Position,
+		"updated_at": time.Now(),
+	}}
+	if req.ParentId == 0 {
+		updateReq["$unset"] = bson.M{"parent_id": ""}
+	} else {
+		updateReq["$set"].(bson.M)["parent_id"] = req.ParentId
+	}
+
+	var response categoryDoc
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := p.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		if req.ParentId != 0 {
+			if _, err := nextID(sc, p.database, "category_moves"); err != nil {
+				return err
+			}
+		}
+
+		if err := p.checkParent(sc, req.Id, req.ParentId); err != nil {
+			return err
+		}
+
+		err := p.database.Collection("categories").FindOneAndUpdate(sc, bson.M{"id": req.Id}, updateReq, opts).Decode(&response)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return errs.NotFound("category", req.Id)
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return errs.AlreadyExists("category", req.Slug)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.toProto(), nil
+}
+
+// DeleteCategory refuses categories with subcategories and unassigns the
+// products of the deleted one
+func (p *productRepo) DeleteCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Status, error) {
+	collection := p.database.Collection("categories")
+
+	children, err := collection.CountDocuments(ctx, bson.M{"parent_id": req.CategoryId}, options.Count().SetLimit(1))
+	if err != nil {
+		return &pb.Status{Success: false}, err
+	}
+	if children > 0 {
+		return &pb.Status{Success: false}, errs.FailedPrecondition("CATEGORY_HAS_CHILDREN", "category %d has subcategories", req.CategoryId)
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.M{"id": req.CategoryId})
+	if err != nil {
+		return &pb.Status{Success: false}, err
+	}
+	if result.DeletedCount == 0 {
+		return &pb.Status{Success: false}, errs.NotFound("category", req.CategoryId)
+	}
+
+	_, err = p.database.Collection("products").UpdateMany(ctx,
+		bson.M{"category_ids": req.CategoryId},
+		bson.M{"$pull": bson.M{"category_ids": req.CategoryId}},
+	)
+	if err != nil {
+		return &pb.Status{Success: false}, err
+	}
+
+	return &pb.Status{Success: true}, nil
+}
+
+func (p *productRepo) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	if req.ParentId != 0 {
+		if _, err := p.GetCategory(ctx, &pb.GetCategoryId{CategoryId: req.ParentId}); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := bson.M{}
+	switch {
+	case req.Recursive && req.ParentId != 0:
+		tree, err := p.categoryTree(ctx, req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		filter["id"] = bson.M{"$in": tree, "$ne": req.ParentId}
+	case !req.Recursive && req.ParentId != 0:
+		filter["parent_id"] = req.ParentId
+	case !req.Recursive:
+		filter["parent_id"] = nil
+	}
+
+	return p.listCategories(ctx, filter)
+}
+
+// SetProductCategories replaces the categories of a product
+func (p *productRepo) SetProductCategories(ctx context.Context, req *pb.ProductCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	ids := make([]int32, 0, len(req.CategoryIds))
+	seen := make(map[int32]bool, len(req.CategoryIds))
+	for _, id := range req.CategoryIds {
+		if seen[id] {
+			continue
+		}
+		if _, err := p.GetCategory(ctx, &pb.GetCategoryId{CategoryId: id}); err != nil {
+			return nil, err
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	result, err := p.database.Collection("products").UpdateOne(ctx,
+		bson.M{"id": req.ProductId, "deleted_at": nil},
+		bson.M{"$set": bson.M{"category_ids": ids}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+
+	return p.listCategories(ctx, bson.M{"id": bson.M{"$in": ids}})
+}
+
+func (p *productRepo) GetProductCategories(ctx context.Context, req *pb.GetProductId) (*pb.ListCategoriesResponse, error) {
+	filter := bson.M{"id": req.ProductId}
+	if !req.IncludeDeleted {
+		filter["deleted_at"] = nil
+	}
+
+	var product productDoc
+	err := p.database.Collection("products").FindOne(ctx, filter).Decode(&product)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// $in needs an array even when the product has no categories
+	return p.listCategories(ctx, bson.M{"id": bson.M{"$in": append([]int32{}, product.CategoryIds...)}})
+}
+
+// checkParent makes sure parentId exists and, when moving category id, is
+// not id itself or one of its descendants
+func (p *productRepo) checkParent(ctx context.Context, id, parentId int32) error {
+	if parentId == 0 {
+		return nil
+	}
+
+	if _, err := p.GetCategory(ctx, &pb.GetCategoryId{CategoryId: parentId}); err != nil {
+		return err
+	}
+	if id == 0 {
+		return nil
+	}
+
+	tree, err := p.categoryTree(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, descendant := range tree {
+		if descendant == parentId {
+			return errs.InvalidArgument("category %d cannot be moved under itself or its descendant %d", id, parentId)
+		}
+	}
+
+	return nil
+}
+
+// categoryTree returns id and the ids of all its descendants
+func (p *productRepo) categoryTree(ctx context.Context, id int32) ([]int32, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"id": id}}},
+		{{Key: "$graphLookup", Value: bson.M{
+			"from":             "categories",
+			"startWith":        "$id",
+			"connectFromField": "id",
+			"connectToField":   "parent_id",
+			"as":               "descendants",
+		}}},
+		{{Key: "$project", Value: bson.M{"descendants.id": 1}}},
+	}
+
+	cursor, err := p.database.Collection("categories").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	tree := []int32{id}
+	for cursor.Next(ctx) {
+		var doc struct {
+			Descendants []struct {
+				Id int32 `bson:"id"`
+			} `bson:"descendants"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		for _, descendant := range doc.Descendants {
+			tree = append(tree, descendant.Id)
+		}
+	}
+
+	return tree, cursor.Err()
+}
+
+func (p *productRepo) listCategories(ctx context.Context, filter bson.M) (*pb.ListCategoriesResponse, error) {
+	cursor, err := p.database.Collection("categories").Find(ctx, filter, options.Find().SetSort(categorySort))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	response := &pb.ListCategoriesResponse{}
+	for cursor.Next(ctx) {
+		var category categoryDoc
+		if err := cursor.Decode(&category); err != nil {
+			return nil, err
+		}
+		response.Categories = append(response.Categories, category.toProto())
+	}
+
+	return response, cursor.Err()
+}
@@ -0,0 +1,202 @@
+package mongo
+
+import (
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+	"time"
+)
+
+// productDoc is the stored shape of a product. Timestamps are kept as BSON
+// dates and rendered as strings only on the way out.
+type productDoc struct {
+	Id   int32  `bson:"id"`
+	Name string `bson:"name"`
+	// NameKey is the lowercase name, kept for anchored prefix lookups
+	NameKey     string  `bson:"name_key"`
+	Description string  `bson:"description"`
+	Price       float32 `bson:"price"`
+	Amount      int32   `bson:"amount"`
+	// Reserved is the quantity active reservations hold; missing means none
+	Reserved    int32      `bson:"reserved,omitempty"`
+	CreatedAt   time.Time  `bson:"created_at"`
+	UpdatedAt   *time.Time `bson:"updated_at,omitempty"`
+	DeletedAt   *time.Time `bson:"deleted_at,omitempty"`
+	Version     int64      `bson:"version"`
+	CategoryIds []int32    `bson:"category_ids,omitempty"`
+}
+
+// categoryDoc is the stored shape of a category; roots have no parent_id
+type categoryDoc struct {
+	Id        int32      `bson:"id"`
+	ParentId  int32      `bson:"parent_id,omitempty"`
+	Name      string     `bson:"name"`
+	Slug      string     `bson:"slug"`
+	Position  int32      `bson:"position"`
+	CreatedAt time.Time  `bson:"created_at"`
+	UpdatedAt *time.Time `bson:"updated_at,omitempty"`
+}
+
+// purchaseDoc is one row of users_products, a line of an order
+type purchaseDoc struct {
+	OrderId   int32     `bson:"order_id"`
+	UserId    string    `bson:"user_id"`
+	ProductId int32     `bson:"product_id"`
+	Amount    int32     `bson:"amount"`
+	UnitPrice float32   `bson:"unit_price"`
+	CreatedAt time.Time `bson:"created_at"`
+	// Returned counts the units sent back so far; missing means none
+	Returned int32 `bson:"returned"`
+}
+
+// returnDoc is the stored shape of an order return
+type returnDoc struct {
+	Id           int32           `bson:"id"`
+	OrderId      int32           `bson:"order_id"`
+	ProductId    int32           `bson:"product_id"`
+	Quantity     int32           `bson:"quantity"`
+	Reason       pb.ReturnReason `bson:"reason"`
+	Restocked    bool            `bson:"restocked"`
+	RefundAmount float32         `bson:"refund_amount"`
+	CreatedAt    time.Time       `bson:"created_at"`
+}
+
+// reservationDoc is the stored shape of a stock reservation
+type reservationDoc struct {
+	Id        int32                `bson:"id"`
+	ProductId int32                `bson:"product_id"`
+	Quantity  int32                `bson:"quantity"`
+	Status    pb.ReservationStatus `bson:"status"`
+	ExpiresAt time.Time            `bson:"expires_at"`
+	CreatedAt time.Time            `bson:"created_at"`
+}
+
+// stockMovementDoc is the stored shape of a ledger entry; entries are only
+// ever inserted
+type stockMovementDoc struct {
+	Id        int32                  `bson:"id"`
+	ProductId int32                  `bson:"product_id"`
+	Delta     int32                  `bson:"delta"`
+	Reason    pb.StockMovementReason `bson:"reason"`
+	Actor     string                 `bson:"actor"`
+	Reference string                 `bson:"reference,omitempty"`
+	Balance   int32                  `bson:"balance"`
+	CreatedAt time.Time              `bson:"created_at"`
+}
+
+// orderDoc is the stored shape of an order; items are embedded and the
+// totals are derived on the way out
+type orderDoc struct {
+	Id        int32          `bson:"id"`
+	UserId    string         `bson:"user_id"`
+	Items     []orderItemDoc `bson:"items"`
+	CreatedAt time.Time      `bson:"created_at"`
+	// Status is missing on orders placed before statuses existed, which
+	// decodes as pending
+	Status      pb.OrderStatus `bson:"status"`
+	PaidAt      *time.Time     `bson:"paid_at,omitempty"`
+	ShippedAt   *time.Time     `bson:"shipped_at,omitempty"`
+	DeliveredAt *time.Time     `bson:"delivered_at,omitempty"`
+	CancelledAt *time.Time     `bson:"cancelled_at,omitempty"`
+	RefundedAt  *time.Time     `bson:"refunded_at,omitempty"`
+}
+
+type orderItemDoc struct {
+	ProductId int32   `bson:"product_id"`
+	Quantity  int32   `bson:"quantity"`
+	UnitPrice float32 `bson:"unit_price"`
+}
+
+func (d *productDoc) toProto() *pb.Product {
+	return &pb.Product{
+		Id:          d.Id,
+		Name:        d.Name,
+		Description: d.Description,
+		Price:       d.Price,
+		Amount:      d.Amount,
+		CreatedAt:   formatTime(&d.CreatedAt),
+		UpdatedAt:   formatTime(d.UpdatedAt),
+		Deleted:     formatTime(d.DeletedAt),
+		Version:     d.Version,
+	}
+}
+
+func (d *categoryDoc) toProto() *pb.Category {
+	return &pb.Category{
+		Id:        d.Id,
+		ParentId:  d.ParentId,
+		Name:      d.Name,
+		Slug:      d.Slug,
+		Position:  d.Position,
+		CreatedAt: formatTime(&d.CreatedAt),
+		UpdatedAt: formatTime(d.UpdatedAt),
+	}
+}
+
+func (d *orderDoc) toProto() *pb.Order {
+	order := &pb.Order{
+		Id:          d.Id,
+		UserId:      d.UserId,
+		CreatedAt:   formatTime(&d.CreatedAt),
+		Status:      d.Status,
+		PaidAt:      formatTime(d.PaidAt),
+		ShippedAt:   formatTime(d.ShippedAt),
+		DeliveredAt: formatTime(d.DeliveredAt),
+		CancelledAt: formatTime(d.CancelledAt),
+		RefundedAt:  formatTime(d.RefundedAt),
+	}
+	for _, item := range d.Items {
+		order.Items = append(order.Items, &pb.OrderItem{
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		})
+	}
+	repo.SetOrderTotals(order)
+
+	return order
+}
+
+// formatTime renders t the way lib/pq renders timestamp columns, or "" when unset
+func (d *purchaseDoc) toProto() *pb.Purchase {
+	return &pb.Purchase{
+		OrderId:          d.OrderId,
+		ProductId:        d.ProductId,
+		Quantity:         d.Amount,
+		ReturnedQuantity: d.Returned,
+		NetQuantity:      d.Amount - d.Returned,
+		UnitPrice:        d.UnitPrice,
+		CreatedAt:        formatTime(&d.CreatedAt),
+	}
+}
+
+func (d *reservationDoc) toProto() *pb.Reservation {
+	return &pb.Reservation{
+		Id:        d.Id,
+		ProductId: d.ProductId,
+		Quantity:  d.Quantity,
+		Status:    d.Status,
+		ExpiresAt: formatTime(&d.ExpiresAt),
+		CreatedAt: formatTime(&d.CreatedAt),
+	}
+}
+
+func (d *stockMovementDoc) toProto() *pb.StockMovement {
+	return &pb.StockMovement{
+		Id:        int64(d.Id),
+		ProductId: d.ProductId,
+		Delta:     d.Delta,
+		Reason:    d.Reason,
+		Actor:     d.Actor,
+		Reference: d.Reference,
+		Balance:   d.Balance,
+		CreatedAt: formatTime(&d.CreatedAt),
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
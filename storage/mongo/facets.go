@@ -0,0 +1,102 @@
+package mongo
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// aboveBounds is the $bucket id of prices at or above the last bound
+const aboveBounds = "above"
+
+// facets aggregates the products matching filter in a single $facet stage
+func (p *productRepo) facets(ctx context.Context, spec *repo.FacetSpec, filter bson.M) (*pb.Facets, error) {
+	count := bson.M{"count": bson.M{"$sum": 1}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$facet", Value: bson.M{
+			"categories": bson.A{
+				bson.M{"$unwind": "$category_ids"},
+				bson.M{"$group": bson.M{"_id": "$category_ids", "count": bson.M{"$sum": 1}}},
+			},
+			"prices": bson.A{
+				bson.M{"$bucket": bson.M{
+					"groupBy":    "$price",
+					"boundaries": spec.Bounds,
+					"default":    aboveBounds,
+					"output":     count,
+				}},
+			},
+			"stock": bson.A{
+				bson.M{"$group": bson.M{"_id": availableAtLeast(1), "count": bson.M{"$sum": 1}}},
+			},
+		}}},
+	}
+
+	cursor, err := p.database.Collection("products").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result struct {
+		Categories []struct {
+			Id    int32 `bson:"_id"`
+			Count int64 `bson:"count"`
+		} `bson:"categories"`
+		Prices []struct {
+			Id    interface{} `bson:"_id"`
+			Count int64       `bson:"count"`
+		} `bson:"prices"`
+		Stock []struct {
+			Id    bool  `bson:"_id"`
+			Count int64 `bson:"count"`
+		} `bson:"stock"`
+	}
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	counter := spec.Counter()
+
+	ids := make([]int32, 0, len(result.Categories))
+	for _, category := range result.Categories {
+		ids = append(ids, category.Id)
+	}
+	categories, err := p.listCategories(ctx, bson.M{"id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int32]string, len(categories.Categories))
+	for _, category := range categories.Categories {
+		names[category.Id] = category.Name
+	}
+	for _, category := range result.Categories {
+		counter.AddCategory(category.Id, names[category.Id], category.Count)
+	}
+
+	// $bucket ids are the lower bounds, except for the open-ended last bucket
+	for _, bucket := range result.Prices {
+		switch bound := bucket.Id.(type) {
+		case float64:
+			counter.AddBucket(spec.Bucket(float32(bound)), bucket.Count)
+		default:
+			counter.AddBucket(len(spec.Bounds)-1, bucket.Count)
+		}
+	}
+
+	for _, stock := range result.Stock {
+		counter.AddStock(stock.Id, stock.Count)
+	}
+
+	return counter.Facets(), nil
+}
@@ -0,0 +1,146 @@
+package mongo
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (p *productRepo) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	movementFilter, err := repo.NewMovementFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"product_id": movementFilter.ProductId}
+	createdAt := bson.M{}
+	if !movementFilter.From.IsZero() {
+		createdAt["$gte"] = movementFilter.From
+	}
+	if !movementFilter.To.IsZero() {
+		createdAt["$lt"] = movementFilter.To
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+
+	collection := p.database.Collection("stock_movements")
+
+	total, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "id", Value: 1}}).
+		SetSkip(movementFilter.Offset).
+		SetLimit(int64(movementFilter.Limit))
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	response := &pb.ListStockMovementsResponse{TotalCount: total}
+	for cursor.Next(ctx) {
+		var movement stockMovementDoc
+		if err := cursor.Decode(&movement); err != nil {
+			return nil, err
+		}
+		response.Movements = append(response.Movements, movement.toProto())
+	}
+
+	return response, cursor.Err()
+}
+
+// ReconcileStock sums the ledger of every product with one $group and
+// compares the sums with the product amounts, soft-deleted products included
+func (p *productRepo) ReconcileStock(ctx context.Context, req *pb.ReconcileStockRequest) (*pb.ReconcileStockResponse, error) {
+	match := bson.M{}
+	if req.ProductId != 0 {
+		match["product_id"] = req.ProductId
+	}
+
+	cursor, err := p.database.Collection("stock_movements").Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{"_id": "$product_id", "total": bson.M{"$sum": "$delta"}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sums []struct {
+		ProductId int32 `bson:"_id"`
+		Total     int32 `bson:"total"`
+	}
+	if err := cursor.All(ctx, &sums); err != nil {
+		return nil, err
+	}
+	ledger := make(map[int32]int32, len(sums))
+	for _, sum := range sums {
+		ledger[sum.ProductId] = sum.Total
+	}
+
+	filter := bson.M{}
+	if req.ProductId != 0 {
+		filter["id"] = req.ProductId
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"id": 1, "amount": 1}).
+		SetSort(bson.D{{Key: "id", Value: 1}})
+
+	products, err := p.database.Collection("products").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer products.Close(ctx)
+
+	response := &pb.ReconcileStockResponse{}
+	for products.Next(ctx) {
+		var product productDoc
+		if err := products.Decode(&product); err != nil {
+			return nil, err
+		}
+		response.Checked++
+		if ledger[product.Id] != product.Amount {
+			response.Discrepancies = append(response.Discrepancies, &pb.StockDiscrepancy{
+				ProductId:    product.Id,
+				Amount:       product.Amount,
+				LedgerAmount: ledger[product.Id],
+			})
+		}
+	}
+
+	return response, products.Err()
+}
+
+// recordMovement appends movement to the ledger; sc must be the transaction
+// that changed the amount. Changes of 0 are not recorded.
+func (p *productRepo) recordMovement(sc mongo.SessionContext, movement *pb.StockMovement) error {
+	if movement.Delta == 0 {
+		return nil
+	}
+
+	id, err := nextID(sc, p.database, "stock_movements")
+	if err != nil {
+		return err
+	}
+
+	_, err = p.database.Collection("stock_movements").InsertOne(sc, stockMovementDoc{
+		Id:        id,
+		ProductId: movement.ProductId,
+		Delta:     movement.Delta,
+		Reason:    movement.Reason,
+		Actor:     movement.Actor,
+		Reference: movement.Reference,
+		Balance:   movement.Balance,
+		CreatedAt: time.Now().Truncate(time.Millisecond),
+	})
+
+	return err
+}
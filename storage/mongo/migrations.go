@@ -0,0 +1,385 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/migrate"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Migrations is the schema history of the Mongo backend, the counterpart
+// of the SQL files in migrations/. Append new steps; never edit old ones.
+var Migrations = []migrate.MongoMigration{
+	{
+		Migration: migrate.Migration{Version: 1, Name: "create_products_indexes"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			_, err := database.Collection("products").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "id", Value: 1}},
+				Options: options.Index().SetName("products_id_unique").SetUnique(true),
+			})
+			return err
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			return dropIndex(ctx, database.Collection("products"), "products_id_unique")
+		},
+	},
+	{
+		Migration: migrate.Migration{Version: 2, Name: "create_users_products_indexes"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			_, err := database.Collection("users_products").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "user_id", Value: 1}},
+				Options: options.Index().SetName("users_products_user_id"),
+			})
+			return err
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			return dropIndex(ctx, database.Collection("users_products"), "users_products_user_id")
+		},
+	},
+	{
+		Migration: migrate.Migration{Version: 3, Name: "create_categories_indexes"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			_, err := database.Collection("categories").Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: "id", Value: 1}},
+					Options: options.Index().SetName("categories_id_unique").SetUnique(true),
+				},
+				{
+					Keys:    bson.D{{Key: "slug", Value: 1}},
+					Options: options.Index().SetName("categories_slug_unique").SetUnique(true),
+				},
+				{
+					Keys:    bson.D{{Key: "parent_id", Value: 1}, {Key: "position", Value: 1}},
+					Options: options.Index().SetName("categories_parent_id"),
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = database.Collection("products").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "category_ids", Value: 1}},
+				Options: options.Index().SetName("products_category_ids"),
+			})
+			return err
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			if err := dropIndex(ctx, database.Collection("products"), "products_category_ids"); err != nil {
+				return err
+			}
+
+			return database.Collection("categories").Drop(ctx)
+		},
+	},
+	{
+		// keyset pages seek on (sort field, id)
+		Migration: migrate.Migration{Version: 4, Name: "create_products_sort_indexes"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			var models []mongo.IndexModel
+			for _, field := range sortIndexFields {
+				models = append(models, mongo.IndexModel{
+					Keys:    bson.D{{Key: field, Value: 1}, {Key: "id", Value: 1}},
+					Options: options.Index().SetName("products_" + field + "_id"),
+				})
+			}
+
+			_, err := database.Collection("products").Indexes().CreateMany(ctx, models)
+			return err
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			for _, field := range sortIndexFields {
+				if err := dropIndex(ctx, database.Collection("products"), "products_"+field+"_id"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	},
+	{
+		Migration: migrate.Migration{Version: 5, Name: "create_products_text_index"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			_, err := database.Collection("products").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}},
+				Options: options.Index().
+					SetName("products_text").
+					SetWeights(bson.M{"name": 10, "description": 2}).
+					SetDefaultLanguage("english"),
+			})
+			return err
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			return dropIndex(ctx, database.Collection("products"), "products_text")
+		},
+	},
+	{
+		Migration: migrate.Migration{Version: 6, Name: "add_products_name_key"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			collection := database.Collection("products")
+
+			// $toLower only folds ASCII; new writes set name_key with strings.ToLower
+			_, err := collection.UpdateMany(ctx,
+				bson.M{"name_key": bson.M{"$exists": false}},
+				mongo.Pipeline{{{Key: "$set", Value: bson.M{"name_key": bson.M{"$toLower": "$name"}}}}},
+			)
+			if err != nil {
+				return err
+			}
+
+			_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "name_key", Value: 1}, {Key: "id", Value: 1}},
+				Options: options.Index().SetName("products_name_key"),
+			})
+			return err
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			collection := database.Collection("products")
+			if err := dropIndex(ctx, collection, "products_name_key"); err != nil {
+				return err
+			}
+
+			_, err := collection.UpdateMany(ctx, bson.M{}, bson.M{"$unset": bson.M{"name_key": ""}})
+			return err
+		},
+	},
+	{
+		Migration: migrate.Migration{Version: 7, Name: "create_orders"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			_, err := database.Collection("orders").Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: "id", Value: 1}},
+					Options: options.Index().SetName("orders_id_unique").SetUnique(true),
+				},
+				{
+					Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "id", Value: 1}},
+					Options: options.Index().SetName("orders_user_id"),
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			return backfillOrders(ctx, database)
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			_, err := database.Collection("users_products").UpdateMany(ctx, bson.M{},
+				bson.M{"$unset": bson.M{"order_id": "", "unit_price": ""}})
+			if err != nil {
+				return err
+			}
+
+			return database.Collection("orders").Drop(ctx)
+		},
+	},
+	{
+		Migration: migrate.Migration{Version: 8, Name: "create_order_returns"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			_, err := database.Collection("users_products").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "order_id", Value: 1}, {Key: "product_id", Value: 1}},
+				Options: options.Index().SetName("users_products_order_product").SetUnique(true),
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = database.Collection("order_returns").Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: "id", Value: 1}},
+					Options: options.Index().SetName("order_returns_id_unique").SetUnique(true),
+				},
+				{
+					Keys:    bson.D{{Key: "order_id", Value: 1}, {Key: "product_id", Value: 1}},
+					Options: options.Index().SetName("order_returns_order_id"),
+				},
+			})
+			return err
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			if err := database.Collection("order_returns").Drop(ctx); err != nil {
+				return err
+			}
+
+			_, err := database.Collection("users_products").UpdateMany(ctx, bson.M{},
+				bson.M{"$unset": bson.M{"returned": ""}})
+			if err != nil {
+				return err
+			}
+
+			_, err = database.Collection("users_products").Indexes().DropOne(ctx, "users_products_order_product")
+			return err
+		},
+	},
+	{
+		Migration: migrate.Migration{Version: 9, Name: "create_stock_reservations"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			_, err := database.Collection("stock_reservations").Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: "id", Value: 1}},
+					Options: options.Index().SetName("stock_reservations_id_unique").SetUnique(true),
+				},
+				{
+					// the sweeper only ever looks at active reservations, status 0
+					Keys: bson.D{{Key: "expires_at", Value: 1}},
+					Options: options.Index().SetName("stock_reservations_active_expiry").
+						SetPartialFilterExpression(bson.M{"status": int32(0)}),
+				},
+			})
+			return err
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			if err := database.Collection("stock_reservations").Drop(ctx); err != nil {
+				return err
+			}
+
+			_, err := database.Collection("products").UpdateMany(ctx, bson.M{},
+				bson.M{"$unset": bson.M{"reserved": ""}})
+			return err
+		},
+	},
+	{
+		Migration: migrate.Migration{Version: 10, Name: "create_stock_movements"},
+		Up: func(ctx context.Context, database *mongo.Database) error {
+			_, err := database.Collection("stock_movements").Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: "id", Value: 1}},
+					Options: options.Index().SetName("stock_movements_id_unique").SetUnique(true),
+				},
+				{
+					Keys:    bson.D{{Key: "product_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "id", Value: 1}},
+					Options: options.Index().SetName("stock_movements_product_created_at"),
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			return backfillOpeningBalances(ctx, database)
+		},
+		Down: func(ctx context.Context, database *mongo.Database) error {
+			return database.Collection("stock_movements").Drop(ctx)
+		},
+	},
+}
+
+// sortIndexFields are the product fields ListProducts can sort by besides id
+var sortIndexFields = []string{"price", "name", "created_at", "amount"}
+
+// backfillOrders turns every purchase made before orders existed into an
+// order of its own, priced at the current product price. Those purchases
+// were completed long ago, so the orders are stored as delivered. Each
+// purchase is moved in a transaction of its own, so a rerun after a failure
+// picks up exactly the purchases that have no order yet.
+func backfillOrders(ctx context.Context, database *mongo.Database) error {
+	purchases := database.Collection("users_products")
+
+	session, err := database.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	cursor, err := purchases.Find(ctx, bson.M{"order_id": bson.M{"$exists": false}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var purchase struct {
+			Id          interface{} `bson:"_id"`
+			purchaseDoc `bson:",inline"`
+		}
+		if err := cursor.Decode(&purchase); err != nil {
+			return err
+		}
+
+		_, err := session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+			var product productDoc
+			err := database.Collection("products").FindOne(sc, bson.M{"id": purchase.ProductId}).Decode(&product)
+			if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, err
+			}
+
+			id, err := nextID(sc, database, "orders")
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = database.Collection("orders").InsertOne(sc, orderDoc{
+				Id:          id,
+				UserId:      purchase.UserId,
+				Items:       []orderItemDoc{{ProductId: purchase.ProductId, Quantity: purchase.Amount, UnitPrice: product.Price}},
+				CreatedAt:   purchase.CreatedAt,
+				Status:      pb.OrderStatus_ORDER_DELIVERED,
+				PaidAt:      &purchase.CreatedAt,
+				ShippedAt:   &purchase.CreatedAt,
+				DeliveredAt: &purchase.CreatedAt,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = purchases.UpdateByID(sc, purchase.Id, bson.M{"$set": bson.M{"order_id": id, "unit_price": product.Price}})
+			return nil, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// backfillOpeningBalances opens the ledger of every product that has stock,
+// so the ledger of existing products sums up to their amount
+func backfillOpeningBalances(ctx context.Context, database *mongo.Database) error {
+	cursor, err := database.Collection("products").Find(ctx, bson.M{"amount": bson.M{"$ne": 0}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var product productDoc
+		if err := cursor.Decode(&product); err != nil {
+			return err
+		}
+
+		id, err := nextID(ctx, database, "stock_movements")
+		if err != nil {
+			return err
+		}
+
+		_, err = database.Collection("stock_movements").InsertOne(ctx, stockMovementDoc{
+			Id:        id,
+			ProductId: product.Id,
+			Delta:     product.Amount,
+			Reason:    pb.StockMovementReason_MOVEMENT_OPENING,
+			Actor:     "migration",
+			Balance:   product.Amount,
+			CreatedAt: time.Now().Truncate(time.Millisecond),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// dropIndex drops name, ignoring indexes or collections that are already gone
+func dropIndex(ctx context.Context, collection *mongo.Collection, name string) error {
+	_, err := collection.Indexes().DropOne(ctx, name)
+
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && (cmdErr.Name == "IndexNotFound" || cmdErr.Name == "NamespaceNotFound") {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,338 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/storage/repo"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateOrder takes stock for every line and records the order in one
+// multi-document transaction
+func (p *productRepo) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.Order, error) {
+	lines, err := repo.MergeOrderLines(req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	var order *orderDoc
+	err = p.withTransaction(ctx, func(sc mongo.SessionContext) (err error) {
+		order, _, err = p.placeOrder(sc, req.UserId, lines)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order.toProto(), nil
+}
+
+func (p *productRepo) GetOrder(ctx context.Context, req *pb.GetOrderId) (*pb.Order, error) {
+	var order orderDoc
+	err := p.database.Collection("orders").FindOne(ctx, bson.M{"id": req.OrderId}).Decode(&order)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound("order", req.OrderId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return order.toProto(), nil
+}
+
+func (p *productRepo) ListOrdersByUser(ctx context.Context, req *pb.ListOrdersByUserRequest) (*pb.ListOrdersResponse, error) {
+	collection := p.database.Collection("orders")
+	filter := bson.M{"user_id": req.UserId}
+
+	response := &pb.ListOrdersResponse{}
+
+	var err error
+	if response.TotalCount, err = collection.CountDocuments(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "id", Value: -1}}).
+		SetSkip(int64(req.Page-1) * int64(req.Limit)).
+		SetLimit(int64(req.Limit))
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var order orderDoc
+		if err := cursor.Decode(&order); err != nil {
+			return nil, err
+		}
+		response.Orders = append(response.Orders, order.toProto())
+	}
+
+	return response, cursor.Err()
+}
+
+// TransitionOrder moves an order to req.Status in a transaction, so a
+// concurrent transition of the same order conflicts and is checked again on
+// retry; cancelling puts the stock back
+func (p *productRepo) TransitionOrder(ctx context.Context, req *pb.TransitionOrderRequest) (*pb.Order, error) {
+	var order orderDoc
+	err := p.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		collection := p.database.Collection("orders")
+
+		var current orderDoc
+		err := collection.FindOne(sc, bson.M{"id": req.OrderId}).Decode(&current)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return errs.NotFound("order", req.OrderId)
+		}
+		if err != nil {
+			return err
+		}
+		if err := repo.CheckTransition(req.OrderId, current.Status, req.Status); err != nil {
+			return err
+		}
+
+		updateReq := bson.M{"$set": bson.M{
+			"status":                      req.Status,
+			repo.StatusColumn(req.Status): time.Now(),
+		}}
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+		if err := collection.FindOneAndUpdate(sc, bson.M{"id": req.OrderId}, updateReq, opts).Decode(&order); err != nil {
+			return err
+		}
+
+		if req.Status == pb.OrderStatus_ORDER_CANCELLED {
+			return p.restockOrder(sc, &order)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order.toProto(), nil
+}
+
+// restockOrder puts the items of order back in stock. Returned units were
+// restocked, or not, by the return itself. Soft-deleted products get their
+// stock back too.
+func (p *productRepo) restockOrder(sc mongo.SessionContext, order *orderDoc) error {
+	cursor, err := p.database.Collection("users_products").Find(sc, bson.M{"order_id": order.Id},
+		options.Find().SetSort(bson.D{{Key: "product_id", Value: 1}}))
+	if err != nil {
+		return err
+	}
+
+	var lines []purchaseDoc
+	if err := cursor.All(sc, &lines); err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		quantity := line.Amount - line.Returned
+		if quantity == 0 {
+			continue
+		}
+
+		err := p.restock(sc, line.ProductId, quantity, pb.StockMovementReason_MOVEMENT_CANCELLATION, repo.OrderRef(order.Id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restock puts quantity back in stock and records it in the ledger. Unlike
+// increase it also restocks soft-deleted products, which orders may still
+// hold.
+func (p *productRepo) restock(sc mongo.SessionContext, productId, quantity int32, reason pb.StockMovementReason, ref string) error {
+	var product productDoc
+	err := p.database.Collection("products").FindOneAndUpdate(sc,
+		bson.M{"id": productId},
+		bson.M{
+			"$inc": bson.M{"amount": quantity, "version": 1},
+			"$set": bson.M{"updated_at": time.Now()},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&product)
+	if err != nil {
+		return err
+	}
+
+	return p.recordMovement(sc, repo.NewMovement(sc, productId, quantity, product.Amount, reason, ref))
+}
+
+// ReturnOrderItem records a return in a transaction, so concurrent returns
+// of the same line conflict and are checked again on retry
+func (p *productRepo) ReturnOrderItem(ctx context.Context, req *pb.ReturnOrderItemRequest) (*pb.OrderReturn, error) {
+	var orderReturn *pb.OrderReturn
+	err := p.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		var order orderDoc
+		err := p.database.Collection("orders").FindOne(sc, bson.M{"id": req.OrderId}).Decode(&order)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return errs.NotFound("order", req.OrderId)
+		}
+		if err != nil {
+			return err
+		}
+		if err := repo.CheckReturnable(req.OrderId, order.Status); err != nil {
+			return err
+		}
+
+		purchases := p.database.Collection("users_products")
+		line := bson.M{"order_id": req.OrderId, "product_id": req.ProductId}
+
+		var purchase purchaseDoc
+		err = purchases.FindOne(sc, line).Decode(&purchase)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return repo.OrderItemNotFound(req.OrderId, req.ProductId)
+		}
+		if err != nil {
+			return err
+		}
+		if err := repo.CheckReturnQuantity(purchase.toProto(), req.Quantity); err != nil {
+			return err
+		}
+
+		if req.Restock {
+			err := p.restock(sc, req.ProductId, req.Quantity, pb.StockMovementReason_MOVEMENT_RETURN, repo.OrderRef(req.OrderId))
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, err := purchases.UpdateOne(sc, line, bson.M{"$inc": bson.M{"returned": req.Quantity}}); err != nil {
+			return err
+		}
+
+		id, err := nextID(sc, p.database, "order_returns")
+		if err != nil {
+			return err
+		}
+
+		orderReturn = repo.NewOrderReturn(req, purchase.toProto())
+		doc := returnDoc{
+			Id:           id,
+			OrderId:      orderReturn.OrderId,
+			ProductId:    orderReturn.ProductId,
+			Quantity:     orderReturn.Quantity,
+			Reason:       orderReturn.Reason,
+			Restocked:    orderReturn.Restocked,
+			RefundAmount: orderReturn.RefundAmount,
+			CreatedAt:    time.Now().Truncate(time.Millisecond),
+		}
+		if _, err := p.database.Collection("order_returns").InsertOne(sc, doc); err != nil {
+			return err
+		}
+		orderReturn.Id = doc.Id
+		orderReturn.CreatedAt = formatTime(&doc.CreatedAt)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orderReturn, nil
+}
+
+// withTransaction runs fn in a multi-document transaction
+func (p *productRepo) withTransaction(ctx context.Context, fn func(sc mongo.SessionContext) error) error {
+	session, err := p.database.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+
+	return err
+}
+
+// placeOrder takes stock for lines, which must be merged and sorted by
+// MergeOrderLines, and records them as one order. It returns the order and
+// the updated products in line order.
+func (p *productRepo) placeOrder(sc mongo.SessionContext, userId string, lines []*pb.OrderLine) (*orderDoc, []*pb.Product, error) {
+	id, err := nextID(sc, p.database, "orders")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order := &orderDoc{Id: id, UserId: userId, CreatedAt: time.Now().Truncate(time.Millisecond)}
+	products := make([]*pb.Product, 0, len(lines))
+	purchases := make([]interface{}, 0, len(lines))
+	for _, line := range lines {
+		product, err := p.takeStock(sc, line.ProductId, line.Quantity)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = p.recordMovement(sc, repo.NewMovement(sc, product.Id, -line.Quantity, product.Amount,
+			pb.StockMovementReason_MOVEMENT_SALE, repo.OrderRef(id)))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		order.Items = append(order.Items, orderItemDoc{
+			ProductId: line.ProductId,
+			Quantity:  line.Quantity,
+			UnitPrice: product.Price,
+		})
+		purchases = append(purchases, purchaseDoc{
+			OrderId:   id,
+			UserId:    userId,
+			ProductId: line.ProductId,
+			Amount:    line.Quantity,
+			UnitPrice: product.Price,
+			CreatedAt: order.CreatedAt,
+		})
+		products = append(products, product)
+	}
+
+	if _, err := p.database.Collection("orders").InsertOne(sc, order); err != nil {
+		return nil, nil, err
+	}
+	if _, err := p.database.Collection("users_products").InsertMany(sc, purchases); err != nil {
+		return nil, nil, err
+	}
+
+	return order, products, nil
+}
+
+// takeStock removes quantity from a live product, failing when there is not enough
+func (p *productRepo) takeStock(sc mongo.SessionContext, productId, quantity int32) (*pb.Product, error) {
+	filter := bson.M{
+		"id":         productId,
+		"deleted_at": nil,
+		"$expr":      availableAtLeast(quantity),
+	}
+	updateReq := bson.M{
+		"$inc": bson.M{"amount": -quantity, "version": 1},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+
+	var product productDoc
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := p.database.Collection("products").FindOneAndUpdate(sc, filter, updateReq, opts).Decode(&product)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		check, err := p.CheckAmount(sc, &pb.GetProductId{ProductId: productId})
+		if err != nil {
+			return nil, err
+		}
+		return nil, errs.InsufficientStock(productId, check.Amount, quantity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return product.toProto(), nil
+}
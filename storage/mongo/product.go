@@ -2,9 +2,13 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
 	"exam/product-service/pkg/logger"
-	"fmt"
+	"exam/product-service/storage/repo"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -12,6 +16,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// nextVersion bumps the product version on every write
+var nextVersion = bson.M{"version": 1}
+
 type productRepo struct {
 	database *mongo.Database
 	log      logger.Logger
@@ -21,221 +28,569 @@ func NewProductRepo(database *mongo.Database, log logger.Logger) *productRepo {
 	return &productRepo{database: database, log: log}
 }
 
+// CreateProduct allocates the next id from the counters collection, so
+// products get the same increasing int32 ids as in postgres.
 func (p *productRepo) CreateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error) {
-	collection := p.database.Collection("products")
-	result, err := collection.InsertOne(ctx, req)
-	if err != nil {
-		return nil, err
-	}
+	var doc productDoc
+	err := p.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		id, err := nextID(sc, p.database, "products")
+		if err != nil {
+			return err
+		}
+
+		doc = productDoc{
+			Id:          id,
+			Name:        req.Name,
+			NameKey:     strings.ToLower(req.Name),
+			Description: req.Description,
+			Price:       req.Price,
+			Amount:      req.Amount,
+			// BSON dates keep milliseconds; truncate so the reply matches later reads
+			CreatedAt: time.Now().Truncate(time.Millisecond),
+			Version:   1,
+		}
+
+		_, err = p.database.Collection("products").InsertOne(sc, doc)
+		if mongo.IsDuplicateKeyError(err) {
+			return errs.AlreadyExists("product", id)
+		}
+		if err != nil {
+			return err
+		}
 
-	var response pb.Product
-	filter := bson.M{"_id": result.InsertedID}
-	err = collection.FindOne(ctx, filter).Decode(&response)
+		return p.recordMovement(sc, repo.NewMovement(sc, id, doc.Amount, doc.Amount, pb.StockMovementReason_MOVEMENT_OPENING, ""))
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	return doc.toProto(), nil
 }
 
 func (p *productRepo) GetProductById(ctx context.Context, req *pb.GetProductId) (*pb.Product, error) {
 	collection := p.database.Collection("products")
 
-	var response pb.Product
+	var response productDoc
 	filter := bson.M{"id": req.ProductId}
+	if !req.IncludeDeleted {
+		filter["deleted_at"] = nil
+	}
+
 	err := collection.FindOne(ctx, filter).Decode(&response)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	return response.toProto(), nil
+}
+
+// GetProductsByIds fetches every requested product with a single $in query
+func (p *productRepo) GetProductsByIds(ctx context.Context, req *pb.GetProductsByIdsRequest) (*pb.GetProductsByIdsResponse, error) {
+	if len(req.ProductIds) == 0 {
+		return &pb.GetProductsByIdsResponse{}, nil
+	}
+
+	filter := bson.M{"id": bson.M{"$in": req.ProductIds}}
+	if !req.IncludeDeleted {
+		filter["deleted_at"] = nil
+	}
+
+	cursor, err := p.database.Collection("products").Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	found := make(map[int32]*pb.Product, len(req.ProductIds))
+	for cursor.Next(ctx) {
+		var product productDoc
+		if err := cursor.Decode(&product); err != nil {
+			return nil, err
+		}
+		found[product.Id] = product.toProto()
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return repo.InRequestOrder(req.ProductIds, found), nil
 }
 
-func (p *productRepo) UpdateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error) {
+// UpdateProduct writes only the fields named in the update mask. A non-zero
+// product version must match the stored one.
+func (p *productRepo) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	paths, err := repo.UpdateMaskPaths(req)
+	if err != nil {
+		return nil, err
+	}
+
 	collection := p.database.Collection("products")
 
-	var response pb.Product
+	var response productDoc
 
-	filter := bson.M{"id": req.Id}
+	filter := withVersion(bson.M{"id": req.Product.Id, "deleted_at": nil}, req.Product.Version)
 
-	updateReq := bson.M{
-		"$set": bson.M{
-			"name":        req.Name,
-			"description": req.Description,
-			"price":       req.Price,
-			"amount":      req.Amount,
-			"updated_at":  time.Now(),
-		},
+	set := bson.M{"updated_at": time.Now()}
+	for _, path := range paths {
+		set[path] = repo.ProductFieldValue(req.Product, path)
+	}
+	if name, ok := set["name"]; ok {
+		set["name_key"] = strings.ToLower(name.(string))
 	}
+	updateReq := bson.M{"$set": set, "$inc": nextVersion}
+
+	// the document before the update tells the ledger how far the amount moved
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+	err = p.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		var before productDoc
+		err := collection.FindOneAndUpdate(sc, filter, updateReq, opts).Decode(&before)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			if _, err := p.current(sc, req.Product.Id, req.Product.Version); err != nil {
+				return err
+			}
+			return errs.Conflict("product %d changed concurrently, retry", req.Product.Id)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := collection.FindOne(sc, bson.M{"id": req.Product.Id}).Decode(&response); err != nil {
+			return err
+		}
 
-	err := collection.FindOneAndUpdate(ctx, filter, updateReq).Decode(&req)
+		return p.recordMovement(sc, repo.NewMovement(sc, response.Id, response.Amount-before.Amount, response.Amount,
+			pb.StockMovementReason_MOVEMENT_ADJUSTMENT, ""))
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	return response.toProto(), nil
 }
 
+// DeleteProduct only marks the product deleted, so purchases that reference
+// it keep their history; PurgeDeletedProducts removes it for good unless
+// it was ever bought.
 func (p *productRepo) DeleteProduct(ctx context.Context, req *pb.GetProductId) (*pb.Status, error) {
 	collection := p.database.Collection("products")
 
-	filter := bson.M{"id": req.ProductId}
-	_, err := collection.DeleteOne(ctx, filter)
+	filter := bson.M{"id": req.ProductId, "deleted_at": nil}
+	updateReq := bson.M{"$set": bson.M{"deleted_at": time.Now()}, "$inc": nextVersion}
+
+	result, err := collection.UpdateOne(ctx, filter, updateReq)
 	if err != nil {
 		return &pb.Status{Success: false}, err
 	}
+	if result.MatchedCount == 0 {
+		return &pb.Status{Success: false}, errs.NotFound("product", req.ProductId)
+	}
 
 	return &pb.Status{Success: true}, nil
 }
 
+// ListProducts applies the request filters and sort order, breaking ties
+// by id so pages never overlap. A page token switches from skipping to a
+// keyset condition on (sort field, id).
 func (p *productRepo) ListProducts(ctx context.Context, req *pb.GetListRequest) (*pb.GetListResponse, error) {
 	collection := p.database.Collection("products")
 
 	var response pb.GetListResponse
 
+	listFilter, err := repo.NewListFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	direction, comparison := 1, "$gt"
+	if listFilter.Descending {
+		direction, comparison = -1, "$lt"
+	}
+
+	column := listFilter.SortColumn()
+
 	reqOptions := options.Find()
 
-	reqOptions.SetSkip(int64(req.Page-1) * int64(req.Limit))
-	reqOptions.SetLimit(int64(req.Limit))
+	reqOptions.SetSort(bson.D{
+		{Key: column, Value: direction},
+		{Key: "id", Value: direction},
+	})
+
+	// one extra document tells whether there is a next page
+	reqOptions.SetSkip(listFilter.Offset)
+	reqOptions.SetLimit(int64(listFilter.Limit) + 1)
+
+	filter, err := p.listFilter(ctx, req, listFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if listFilter.Facets != nil {
+		if response.Facets, err = p.facets(ctx, listFilter.Facets, filter); err != nil {
+			return nil, err
+		}
+	}
+
+	if after := listFilter.After; after != nil {
+		if column == "id" {
+			filter["id"] = bson.M{comparison: after.Id}
+		} else {
+			filter["$or"] = bson.A{
+				bson.M{column: bson.M{comparison: after.Key}},
+				bson.M{column: after.Key, "id": bson.M{comparison: after.Id}},
+			}
+		}
+	}
 
-	cursor, err := collection.Find(ctx, bson.M{}, reqOptions)
+	cursor, err := collection.Find(ctx, filter, reqOptions)
 	if err != nil {
 		return nil, err
 	}
+	defer cursor.Close(ctx)
 
+	var products []*pb.Product
 	for cursor.Next(ctx) {
-		var product pb.Product
+		var product productDoc
 		err = cursor.Decode(&product)
 		if err != nil {
 			return nil, err
 		}
 
-		response.Count++
-		response.Products = append(response.Products, &product)
+		products = append(products, product.toProto())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
 	}
 
+	listFilter.Fill(&response, products, total)
+
 	return &response, nil
 }
 
-func (p *productRepo) IncreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
-	collection := p.database.Collection("products")
+// listFilter translates the filters of a list request into a query document
+func (p *productRepo) listFilter(ctx context.Context, req *pb.GetListRequest, listFilter *repo.ListFilter) (bson.M, error) {
+	filter := bson.M{}
+	if !req.IncludeDeleted {
+		filter["deleted_at"] = nil
+	}
+	if req.CategoryId != 0 {
+		tree, err := p.categoryTree(ctx, req.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		filter["category_ids"] = bson.M{"$in": tree}
+	}
+	if listFilter.Name != "" {
+		filter["name"] = bson.M{"$regex": regexp.QuoteMeta(listFilter.Name), "$options": "i"}
+	}
 
-	var response pb.Product
-	filter := bson.M{"id": req.ProductId}
-	err := collection.FindOne(ctx, filter).Decode(&response)
-	if err != nil {
-		return &pb.ProductAmountResponse{IsEnough: false, Product: nil}, err
+	price := bson.M{}
+	if listFilter.MinPrice > 0 {
+		price["$gte"] = listFilter.MinPrice
+	}
+	if listFilter.MaxPrice > 0 {
+		price["$lte"] = listFilter.MaxPrice
+	}
+	if len(price) > 0 {
+		filter["price"] = price
 	}
 
-	updateReq := bson.M{
-		"$set": bson.M{
-			"amount":     req.AmountBy + response.Amount,
-			"updated_at": time.Now(),
-		},
+	if listFilter.InStock {
+		filter["$expr"] = availableAtLeast(1)
+	}
+
+	createdAt := bson.M{}
+	if !listFilter.CreatedAfter.IsZero() {
+		createdAt["$gte"] = listFilter.CreatedAfter
 	}
+	if !listFilter.CreatedBefore.IsZero() {
+		createdAt["$lt"] = listFilter.CreatedBefore
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+
+	return filter, nil
+}
 
-	err = collection.FindOneAndUpdate(ctx, filter, updateReq).Decode(&req)
+func (p *productRepo) IncreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
+	var product *pb.Product
+	err := p.withTransaction(ctx, func(sc mongo.SessionContext) (err error) {
+		if product, err = p.increase(sc, req); err != nil {
+			return err
+		}
+		return p.recordMovement(sc, repo.NewMovement(sc, product.Id, req.AmountBy, product.Amount,
+			pb.StockMovementReason_MOVEMENT_INCREASE, ""))
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.ProductAmountResponse{IsEnough: true, Product: &response}, nil
+	return &pb.ProductAmountResponse{IsEnough: true, Product: product}, nil
 }
 
-func (p *productRepo) DecreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
+// increase adds stock to a live product; ctx may be a session context
+func (p *productRepo) increase(ctx context.Context, req *pb.ProductAmountRequest) (*pb.Product, error) {
 	collection := p.database.Collection("products")
 
-	var response pb.Product
-	filter := bson.M{"id": req.ProductId}
-	err := collection.FindOne(ctx, filter).Decode(&response)
-	if err != nil {
-		return &pb.ProductAmountResponse{IsEnough: false, Product: nil}, err
+	var response productDoc
+	filter := withVersion(bson.M{"id": req.ProductId, "deleted_at": nil}, req.ExpectedVersion)
+	updateReq := bson.M{
+		"$inc": bson.M{"amount": req.AmountBy, "version": 1},
+		"$set": bson.M{"updated_at": time.Now()},
 	}
 
-	if response.Amount == 0 {
-		return nil, fmt.Errorf("not enough")
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := collection.FindOneAndUpdate(ctx, filter, updateReq, opts).Decode(&response)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		if _, err := p.current(ctx, req.ProductId, req.ExpectedVersion); err != nil {
+			return nil, err
+		}
+		return nil, errs.Conflict("product %d changed concurrently, retry", req.ProductId)
 	}
-
-	if response.Amount-req.AmountBy < 0 {
-		return &pb.ProductAmountResponse{IsEnough: false, Product: &response}, err
+	if err != nil {
+		return nil, err
 	}
 
+	return response.toProto(), nil
+}
+
+// DecreaseProductAmount takes stock with a filtered $inc, so concurrent
+// callers can never drive the amount below zero.
+func (p *productRepo) DecreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
+	collection := p.database.Collection("products")
+
+	var response productDoc
+	filter := withVersion(bson.M{
+		"id":         req.ProductId,
+		"deleted_at": nil,
+		"$expr":      availableAtLeast(req.AmountBy),
+	}, req.ExpectedVersion)
 	updateReq := bson.M{
-		"$set": bson.M{
-			"amount":     response.Amount - req.AmountBy,
-			"updated_at": time.Now(),
-		},
+		"$inc": bson.M{"amount": -req.AmountBy, "version": 1},
+		"$set": bson.M{"updated_at": time.Now()},
 	}
 
-	err = collection.FindOneAndUpdate(ctx, filter, updateReq).Decode(&req)
-	if err != nil {
-		return nil, err
-	}
+	var result *pb.ProductAmountResponse
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := p.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		err := collection.FindOneAndUpdate(sc, filter, updateReq, opts).Decode(&response)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Nothing matched: the product is missing, was modified or the guard failed.
+			product, err := p.current(sc, req.ProductId, req.ExpectedVersion)
+			if err != nil {
+				return err
+			}
+			check, err := p.CheckAmount(sc, &pb.GetProductId{ProductId: req.ProductId})
+			if err != nil {
+				return err
+			}
+
+			result = &pb.ProductAmountResponse{IsEnough: false, Product: product}
+			return errs.InsufficientStock(product.Id, check.Amount, req.AmountBy)
+		}
+		if err != nil {
+			return err
+		}
 
-	return &pb.ProductAmountResponse{IsEnough: true, Product: &response}, nil
+		err = p.recordMovement(sc, repo.NewMovement(sc, response.Id, -req.AmountBy, response.Amount,
+			pb.StockMovementReason_MOVEMENT_DECREASE, ""))
+		if err != nil {
+			return err
+		}
+		result = &pb.ProductAmountResponse{IsEnough: true, Product: response.toProto()}
+		return nil
+	})
+
+	return result, err
 }
 
 func (p *productRepo) CheckAmount(ctx context.Context, req *pb.GetProductId) (*pb.CheckAmountResponse, error) {
 	collection := p.database.Collection("products")
 
 	var checkResult pb.CheckAmountResponse
-	var response pb.Product
-	filter := bson.M{"id": req.ProductId}
+	var response productDoc
+	filter := bson.M{"id": req.ProductId, "deleted_at": nil}
 	err := collection.FindOne(ctx, filter).Decode(&response)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	checkResult.Amount = response.Amount
+	checkResult.Amount = repo.Available(response.Amount, response.Reserved)
+	checkResult.Reserved = response.Reserved
 	checkResult.ProductId = response.Id
 
 	return &checkResult, nil
 }
 
+// BuyProduct places an order with a single line and returns the product
 func (p *productRepo) BuyProduct(ctx context.Context, req *pb.BuyProductRequest) (*pb.Product, error) {
-	collection := p.database.Collection("users_products")
-
-	_, err := collection.InsertOne(ctx, req)
+	lines, err := repo.MergeOrderLines([]*pb.OrderLine{{ProductId: req.ProductId, Quantity: req.Amount}})
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := p.GetProductById(ctx, &pb.GetProductId{ProductId: req.ProductId})
+	var products []*pb.Product
+	err = p.withTransaction(ctx, func(sc mongo.SessionContext) (err error) {
+		_, products, err = p.placeOrder(sc, req.UserId, lines)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
+	return products[0], nil
 }
 
 func (p *productRepo) GetPurchasedProductsByUserId(ctx context.Context, req *pb.GetUserID) (*pb.GetPurchasedProductsResponse, error) {
 	collection := p.database.Collection("users_products")
 
-	var products []*pb.Product
-
-	cursor, err := collection.Find(ctx, bson.M{"user_id": req.UserId})
+	opts := options.Find().SetSort(bson.D{{Key: "order_id", Value: 1}, {Key: "product_id", Value: 1}})
+	cursor, err := collection.Find(ctx, bson.M{"user_id": req.UserId}, opts)
 	if err != nil {
 		return nil, err
 	}
+	defer cursor.Close(ctx)
 
+	response := &pb.GetPurchasedProductsResponse{}
+	var ids []int32
 	for cursor.Next(ctx) {
-		var order pb.BuyProductRequest
-		err := cursor.Decode(&order)
-		if err != nil {
-			return nil, err
-		}
-
-		product, err := p.GetProductById(ctx, &pb.GetProductId{ProductId: order.ProductId})
+		var purchase purchaseDoc
+		err := cursor.Decode(&purchase)
 		if err != nil {
 			return nil, err
 		}
 
-		products = append(products, product)
+		response.Purchases = append(response.Purchases, purchase.toProto())
+		ids = append(ids, purchase.ProductId)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
 	}
 
-	response := &pb.GetPurchasedProductsResponse{
-		Products: products,
+	// Purchase history outlives soft deletion of the product
+	products, err := p.GetProductsByIds(ctx, &pb.GetProductsByIdsRequest{ProductIds: ids, IncludeDeleted: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(products.MissingIds) > 0 {
+		return nil, errs.NotFound("product", products.MissingIds[0])
 	}
+	response.Products = products.Products
 
 	return response, nil
 }
+
+// RestoreProduct clears the deletion mark; restoring a live product is a no-op
+func (p *productRepo) RestoreProduct(ctx context.Context, req *pb.GetProductId) (*pb.Product, error) {
+	collection := p.database.Collection("products")
+
+	var response productDoc
+	filter := bson.M{"id": req.ProductId}
+	updateReq := bson.M{"$unset": bson.M{"deleted_at": ""}, "$inc": nextVersion}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := collection.FindOneAndUpdate(ctx, filter, updateReq, opts).Decode(&response)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response.toProto(), nil
+}
+
+func (p *productRepo) PurgeDeletedProducts(ctx context.Context, req *pb.PurgeDeletedProductsRequest) (*pb.PurgeDeletedProductsResponse, error) {
+	olderThan, err := time.Parse(time.RFC3339, req.OlderThan)
+	if err != nil {
+		return nil, errs.InvalidArgument("older_than: %v", err)
+	}
+
+	collection := p.database.Collection("products")
+
+	filter := bson.M{"deleted_at": bson.M{"$ne": nil, "$lt": olderThan}}
+	candidates, err := collection.Distinct(ctx, "id", filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return &pb.PurgeDeletedProductsResponse{}, nil
+	}
+
+	// purchase history must outlive the purge
+	purchased, err := p.database.Collection("users_products").
+		Distinct(ctx, "product_id", bson.M{"product_id": bson.M{"$in": candidates}})
+	if err != nil {
+		return nil, err
+	}
+	filter["id"] = bson.M{"$in": candidates, "$nin": purchased}
+
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PurgeDeletedProductsResponse{Purged: result.DeletedCount}, nil
+}
+
+// current explains why a guarded write matched nothing: the product is
+// missing or its version moved past expectedVersion. Otherwise it returns
+// the product as it is now.
+func (p *productRepo) current(ctx context.Context, id int32, expectedVersion int64) (*pb.Product, error) {
+	product, err := p.GetProductById(ctx, &pb.GetProductId{ProductId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedVersion != 0 && product.Version != expectedVersion {
+		return nil, errs.VersionMismatch("product", id, expectedVersion, product.Version)
+	}
+
+	return product, nil
+}
+
+// availableAtLeast is an $expr matching products with at least quantity
+// not held by reservations
+func availableAtLeast(quantity int32) bson.M {
+	return bson.M{"$gte": bson.A{
+		bson.M{"$subtract": bson.A{"$amount", bson.M{"$ifNull": bson.A{"$reserved", 0}}}},
+		quantity,
+	}}
+}
+
+// withVersion adds the optimistic concurrency guard when a version is expected
+func withVersion(filter bson.M, expectedVersion int64) bson.M {
+	if expectedVersion != 0 {
+		filter["version"] = expectedVersion
+	}
+
+	return filter
+}
+
+// nextID atomically increments and returns the sequence named name
+func nextID(ctx context.Context, database *mongo.Database, name string) (int32, error) {
+	var counter struct {
+		Seq int32 `bson:"seq"`
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err := database.Collection("counters").
+		FindOneAndUpdate(ctx, bson.M{"_id": name}, bson.M{"$inc": bson.M{"seq": int32(1)}}, opts).
+		Decode(&counter)
+	if err != nil {
+		return 0, err
+	}
+
+	return counter.Seq, nil
+}
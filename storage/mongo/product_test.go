@@ -0,0 +1,41 @@
+package mongo
+
+import (
+	"context"
+	"exam/product-service/config"
+	"exam/product-service/pkg/db"
+	"exam/product-service/pkg/logger"
+	"exam/product-service/pkg/migrate"
+	"exam/product-service/storage/repo"
+	"exam/product-service/storage/repotest"
+	"testing"
+	"time"
+)
+
+func TestConformance(t *testing.T) {
+	cfg := config.Load()
+	cfg.MongoDatabase += "_test"
+
+	database, err := db.NewMongo(*cfg)
+	if err != nil {
+		t.Skipf("mongo is not configured: %v", err)
+	}
+	defer database.Client().Disconnect(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+	if err := database.Client().Ping(ctx, nil); err != nil {
+		t.Skipf("mongo is not reachable: %v", err)
+	}
+
+	log := logger.New("", "")
+	repotest.Run(t, func(t *testing.T) (repo.ProductServiceI, func()) {
+		if err := migrate.New(migrate.NewMongo(database, Migrations), log).Up(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		return NewProductRepo(database, log), func() {
+			_ = database.Drop(context.Background())
+		}
+	})
+}
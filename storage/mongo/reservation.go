@@ -0,0 +1,178 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/storage/repo"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ReserveStock holds stock with a filtered $inc, so concurrent reservations
+// can never hold more than is in stock
+func (p *productRepo) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.Reservation, error) {
+	var reservation *reservationDoc
+	err := p.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		filter := bson.M{
+			"id":         req.ProductId,
+			"deleted_at": nil,
+			"$expr":      availableAtLeast(req.Quantity),
+		}
+		res, err := p.database.Collection("products").UpdateOne(sc, filter, bson.M{"$inc": bson.M{"reserved": req.Quantity}})
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			check, err := p.CheckAmount(sc, &pb.GetProductId{ProductId: req.ProductId})
+			if err != nil {
+				return err
+			}
+			return errs.InsufficientStock(req.ProductId, check.Amount, req.Quantity)
+		}
+
+		id, err := nextID(sc, p.database, "stock_reservations")
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().Truncate(time.Millisecond)
+		reservation = &reservationDoc{
+			Id:        id,
+			ProductId: req.ProductId,
+			Quantity:  req.Quantity,
+			Status:    pb.ReservationStatus_RESERVATION_ACTIVE,
+			ExpiresAt: repo.ReservationExpiry(req, now),
+			CreatedAt: now,
+		}
+		_, err = p.database.Collection("stock_reservations").InsertOne(sc, reservation)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation.toProto(), nil
+}
+
+func (p *productRepo) CommitReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error) {
+	return p.settle(ctx, req.ReservationId, pb.ReservationStatus_RESERVATION_COMMITTED)
+}
+
+func (p *productRepo) ReleaseReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error) {
+	return p.settle(ctx, req.ReservationId, pb.ReservationStatus_RESERVATION_RELEASED)
+}
+
+// ReleaseExpiredReservations releases every expired reservation in a
+// transaction of its own; reservations settled meanwhile are skipped
+func (p *productRepo) ReleaseExpiredReservations(ctx context.Context, now time.Time) (int64, error) {
+	filter := bson.M{
+		"status":     pb.ReservationStatus_RESERVATION_ACTIVE,
+		"expires_at": bson.M{"$lt": now},
+	}
+	opts := options.Find().SetProjection(bson.M{"id": 1})
+
+	cursor, err := p.database.Collection("stock_reservations").Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	var expired []reservationDoc
+	if err := cursor.All(ctx, &expired); err != nil {
+		return 0, err
+	}
+
+	var released int64
+	for _, reservation := range expired {
+		err := p.withTransaction(ctx, func(sc mongo.SessionContext) error {
+			_, err := p.unreserve(sc, reservation.Id, pb.ReservationStatus_RESERVATION_EXPIRED)
+			return err
+		})
+		if errors.Is(err, errs.ErrFailedPrecondition) {
+			continue
+		}
+		if err != nil {
+			return released, err
+		}
+		released++
+	}
+
+	return released, nil
+}
+
+// settle ends an active reservation; committing takes its quantity from stock
+func (p *productRepo) settle(ctx context.Context, id int32, status pb.ReservationStatus) (*pb.Reservation, error) {
+	var reservation *reservationDoc
+	err := p.withTransaction(ctx, func(sc mongo.SessionContext) (err error) {
+		reservation, err = p.unreserve(sc, id, status)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation.toProto(), nil
+}
+
+// unreserve moves active reservation id to its final status and gives the
+// quantity it holds back; committing takes the quantity from stock as well
+func (p *productRepo) unreserve(sc mongo.SessionContext, id int32, status pb.ReservationStatus) (*reservationDoc, error) {
+	reservations := p.database.Collection("stock_reservations")
+
+	var current reservationDoc
+	err := reservations.FindOne(sc, bson.M{"id": id}).Decode(&current)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound("reservation", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.CheckSettle(id, current.Status, current.ExpiresAt, time.Now(), status); err != nil {
+		return nil, err
+	}
+
+	if _, err := reservations.UpdateOne(sc, bson.M{"id": id}, bson.M{"$set": bson.M{"status": status}}); err != nil {
+		return nil, err
+	}
+	current.Status = status
+
+	products := p.database.Collection("products")
+	if status != pb.ReservationStatus_RESERVATION_COMMITTED {
+		_, err := products.UpdateOne(sc, bson.M{"id": current.ProductId}, bson.M{"$inc": bson.M{"reserved": -current.Quantity}})
+		if err != nil {
+			return nil, err
+		}
+		return &current, nil
+	}
+
+	updateReq := bson.M{
+		"$inc": bson.M{"reserved": -current.Quantity, "amount": -current.Quantity, "version": 1},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+	// the amount may have been set below what is reserved since
+	var product productDoc
+	filter := bson.M{"id": current.ProductId, "amount": bson.M{"$gte": current.Quantity}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = products.FindOneAndUpdate(sc, filter, updateReq, opts).Decode(&product)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		if err := products.FindOne(sc, bson.M{"id": current.ProductId}).Decode(&product); err != nil {
+			return nil, err
+		}
+		return nil, errs.InsufficientStock(current.ProductId, product.Amount, current.Quantity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.recordMovement(sc, repo.NewMovement(sc, current.ProductId, -current.Quantity, product.Amount,
+		pb.StockMovementReason_MOVEMENT_RESERVATION, repo.ReservationRef(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &current, nil
+}
@@ -0,0 +1,186 @@
+package mongo
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+	"regexp"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// fuzzyCandidates bounds the products scored in Go when the text index
+// finds nothing for a short query
+const fuzzyCandidates = 1000
+
+// searchDoc is a product with its textScore
+type searchDoc struct {
+	productDoc `bson:",inline"`
+	Score      float64 `bson:"score"`
+}
+
+// SearchProducts ranks products with the products_text index. The index has
+// no typo tolerance, so a short query without results falls back to
+// scoring candidates that share a word prefix with one of its terms.
+func (p *productRepo) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	page, err := repo.NewSearchPage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := p.listFilter(ctx, &pb.GetListRequest{CategoryId: req.CategoryId}, &repo.ListFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	textFilter := bson.M{"$text": bson.M{"$search": page.Query.Text}}
+	for key, value := range filter {
+		textFilter[key] = value
+	}
+
+	collection := p.database.Collection("products")
+
+	total, err := collection.CountDocuments(ctx, textFilter)
+	if err != nil {
+		return nil, err
+	}
+	if total == 0 && page.Query.Fuzzy {
+		return p.fuzzySearch(ctx, page, filter)
+	}
+
+	response := &pb.SearchProductsResponse{}
+	if page.Facets != nil {
+		if response.Facets, err = p.facets(ctx, page.Facets, textFilter); err != nil {
+			return nil, err
+		}
+	}
+
+	score := bson.M{"$meta": "textScore"}
+	opts := options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}, {Key: "id", Value: 1}}).
+		SetSkip(page.Offset).
+		SetLimit(int64(page.Limit) + 1)
+
+	cursor, err := collection.Find(ctx, textFilter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var hits []*pb.SearchHit
+	for cursor.Next(ctx) {
+		var doc searchDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		hits = append(hits, &pb.SearchHit{Product: doc.toProto(), Score: float32(doc.Score)})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	page.Fill(response, hits, total)
+
+	return response, nil
+}
+
+// fuzzySearch scores products whose name has a word starting like one of
+// the terms; typos within that prefix are not found
+func (p *productRepo) fuzzySearch(ctx context.Context, page *repo.SearchPage, filter bson.M) (*pb.SearchProductsResponse, error) {
+	var prefixes bson.A
+	for _, term := range page.Query.Terms {
+		prefix := []rune(term)
+		if len(prefix) > 2 {
+			prefix = prefix[:2]
+		}
+		prefixes = append(prefixes, bson.M{"name": bson.M{
+			"$regex":   `(^|\W)` + regexp.QuoteMeta(string(prefix)),
+			"$options": "i",
+		}})
+	}
+	filter["$or"] = prefixes
+
+	cursor, err := p.database.Collection("products").Find(ctx, filter, options.Find().SetLimit(fuzzyCandidates))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var hits []*pb.SearchHit
+	for cursor.Next(ctx) {
+		var doc productDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		if score := page.Query.Score(doc.Name, doc.Description); score > 0 {
+			hits = append(hits, &pb.SearchHit{Product: doc.toProto(), Score: float32(score)})
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	repo.SortHits(hits)
+
+	response := &pb.SearchProductsResponse{}
+	if page.Facets != nil {
+		ids := make([]int32, 0, len(hits))
+		for _, hit := range hits {
+			ids = append(ids, hit.Product.Id)
+		}
+		if response.Facets, err = p.facets(ctx, page.Facets, bson.M{"id": bson.M{"$in": ids}}); err != nil {
+			return nil, err
+		}
+	}
+
+	total := int64(len(hits))
+	if page.Offset < total {
+		hits = hits[page.Offset:]
+	} else {
+		hits = nil
+	}
+	if len(hits) > int(page.Limit)+1 {
+		hits = hits[:page.Limit+1]
+	}
+
+	page.Fill(response, hits, total)
+
+	return response, nil
+}
+
+// SuggestProducts looks names up with a regex anchored on name_key, which
+// the products_name_key index serves as a range scan
+func (p *productRepo) SuggestProducts(ctx context.Context, req *pb.SuggestProductsRequest) (*pb.SuggestProductsResponse, error) {
+	filter := bson.M{
+		"name_key":   bson.M{"$regex": "^" + regexp.QuoteMeta(strings.ToLower(req.Prefix))},
+		"deleted_at": nil,
+	}
+	if req.InStock {
+		filter["$expr"] = availableAtLeast(1)
+	}
+
+	opts := options.Find().
+		SetProjection(bson.M{"id": 1, "name": 1}).
+		SetSort(bson.D{{Key: "name_key", Value: 1}, {Key: "id", Value: 1}}).
+		SetLimit(int64(req.Limit))
+
+	cursor, err := p.database.Collection("products").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	response := &pb.SuggestProductsResponse{}
+	for cursor.Next(ctx) {
+		var doc productDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		response.Suggestions = append(response.Suggestions, &pb.Suggestion{ProductId: doc.Id, Name: doc.Name})
+	}
+
+	return response, cursor.Err()
+}
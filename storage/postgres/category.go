@@ -0,0 +1,295 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// categoryColumns is the column list scanCategory expects
+const categoryColumns = `id, parent_id, name, slug, position, created_at, updated_at`
+
+// categoryTree selects a category and all of its descendants; UNION stops
+// the recursion even if the tree ever held a cycle
+const categoryTree = `WITH RECURSIVE tree AS (
+	SELECT id FROM categories WHERE id = ?
+	UNION
+	SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+) SELECT id FROM tree`
+
+// inCategoryTree keeps products assigned anywhere under a category
+const inCategoryTree = `id IN (SELECT product_id FROM products_categories WHERE category_id IN (` + categoryTree + `))`
+
+// categoryOrder is shared by every category listing
+var categoryOrder = []string{"parent_id NULLS FIRST", "position", "id"}
+
+func (u *productRepo) CreateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	if err := u.checkParent(ctx, u.db.DB, 0, req.ParentId); err != nil {
+		return nil, err
+	}
+
+	query := u.db.Builder.Insert("categories").
+		Columns("parent_id, name, slug, position").
+		Values(nullID(req.ParentId), req.Name, req.Slug, req.Position).
+		Suffix("RETURNING " + categoryColumns)
+
+	category, err := scanCategory(query.RunWith(u.db.DB).QueryRowContext(ctx))
+	if isUniqueViolation(err) {
+		return nil, errs.AlreadyExists("category", req.Slug)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (u *productRepo) GetCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Category, error) {
+	query := u.db.Builder.Select(categoryColumns).From("categories").Where(squirrel.Eq{"id": req.CategoryId})
+
+	category, err := scanCategory(query.RunWith(u.db.DB).QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.NotFound("category", req.CategoryId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// UpdateCategory checks and moves the category in one transaction. Moves
+// under a parent take a table lock, so two concurrent moves can never both
+// pass the cycle check and close a loop.
+func (u *productRepo) UpdateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	if _, err := u.GetCategory(ctx, &pb.GetCategoryId{CategoryId: req.Id}); err != nil {
+		return nil, err
+	}
+
+	var category *pb.Category
+	err := u.db.WithTx(ctx, func(tx *sql.Tx) (err error) {
+		if req.ParentId != 0 {
+			// blocks other category writes until commit but lets reads through
+			if _, err := tx.ExecContext(ctx, "LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+				return err
+			}
+		}
+
+		if err := u.checkParent(ctx, tx, req.Id, req.ParentId); err != nil {
+			return err
+		}
+
+		query := u.db.Builder.Update("categories").
+			Set("parent_id", nullID(req.ParentId)).
+			Set("name", req.Name).
+			Set("slug", req.Slug).
+			Set("position", req.Position).
+			Set("updated_at", time.Now()).
+			Where(squirrel.Eq{"id": req.Id}).
+			Suffix("RETURNING " + categoryColumns)
+
+		category, err = scanCategory(query.RunWith(tx).QueryRowContext(ctx))
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.NotFound("category", req.Id)
+		}
+		if isUniqueViolation(err) {
+			return errs.AlreadyExists("category", req.Slug)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// DeleteCategory refuses categories with subcategories; product
+// assignments go with the category
+func (u *productRepo) DeleteCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Status, error) {
+	var hasChildren bool
+	err := u.db.Builder.Select().Column("EXISTS (SELECT 1 FROM categories WHERE parent_id = ?)", req.CategoryId).
+		RunWith(u.db.DB).QueryRowContext(ctx).Scan(&hasChildren)
+	if err != nil {
+		return &pb.Status{Success: false}, err
+	}
+	if hasChildren {
+		return &pb.Status{Success: false}, errs.FailedPrecondition("CATEGORY_HAS_CHILDREN", "category %d has subcategories", req.CategoryId)
+	}
+
+	result, err := u.db.Builder.Delete("categories").Where(squirrel.Eq{"id": req.CategoryId}).
+		RunWith(u.db.DB).ExecContext(ctx)
+	if err != nil {
+		return &pb.Status{Success: false}, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return &pb.Status{Success: false}, err
+	}
+	if affected == 0 {
+		return &pb.Status{Success: false}, errs.NotFound("category", req.CategoryId)
+	}
+
+	return &pb.Status{Success: true}, nil
+}
+
+func (u *productRepo) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	if req.ParentId != 0 {
+		if _, err := u.GetCategory(ctx, &pb.GetCategoryId{CategoryId: req.ParentId}); err != nil {
+			return nil, err
+		}
+	}
+
+	query := u.db.Builder.Select(categoryColumns).From("categories").OrderBy(categoryOrder...)
+	switch {
+	case req.Recursive && req.ParentId != 0:
+		query = query.Where("id IN ("+categoryTree+") AND id <> ?", req.ParentId, req.ParentId)
+	case !req.Recursive:
+		query = query.Where(squirrel.Eq{"parent_id": nullID(req.ParentId)})
+	}
+
+	return u.listCategories(ctx, query)
+}
+
+// SetProductCategories replaces the categories of a product in one transaction
+func (u *productRepo) SetProductCategories(ctx context.Context, req *pb.ProductCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	if _, err := u.GetProductById(ctx, &pb.GetProductId{ProductId: req.ProductId}); err != nil {
+		return nil, err
+	}
+
+	err := u.db.WithTx(ctx, func(tx *sql.Tx) error {
+		_, err := u.db.Builder.Delete("products_categories").Where(squirrel.Eq{"product_id": req.ProductId}).
+			RunWith(tx).ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range req.CategoryIds {
+			_, err := u.db.Builder.Insert("products_categories").
+				Columns("product_id, category_id").
+				Values(req.ProductId, id).
+				Suffix("ON CONFLICT DO NOTHING").
+				RunWith(tx).ExecContext(ctx)
+			if isForeignKeyViolation(err) {
+				return errs.NotFound("category", id)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetProductCategories(ctx, &pb.GetProductId{ProductId: req.ProductId})
+}
+
+func (u *productRepo) GetProductCategories(ctx context.Context, req *pb.GetProductId) (*pb.ListCategoriesResponse, error) {
+	if _, err := u.GetProductById(ctx, req); err != nil {
+		return nil, err
+	}
+
+	query := u.db.Builder.Select(categoryColumns).From("categories").
+		Where("id IN (SELECT category_id FROM products_categories WHERE product_id = ?)", req.ProductId).
+		OrderBy(categoryOrder...)
+
+	return u.listCategories(ctx, query)
+}
+
+// checkParent makes sure parentId exists and, when moving category id, is
+// not id itself or one of its descendants. The cycle check runs through
+// runner, the database or the transaction that moves the category.
+func (u *productRepo) checkParent(ctx context.Context, runner squirrel.BaseRunner, id, parentId int32) error {
+	if parentId == 0 {
+		return nil
+	}
+
+	if _, err := u.GetCategory(ctx, &pb.GetCategoryId{CategoryId: parentId}); err != nil {
+		return err
+	}
+	if id == 0 {
+		return nil
+	}
+
+	var cycle bool
+	err := u.db.Builder.Select().Column("? IN ("+categoryTree+")", parentId, id).
+		RunWith(runner).QueryRowContext(ctx).Scan(&cycle)
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return errs.InvalidArgument("category %d cannot be moved under itself or its descendant %d", id, parentId)
+	}
+
+	return nil
+}
+
+func (u *productRepo) listCategories(ctx context.Context, query squirrel.SelectBuilder) (*pb.ListCategoriesResponse, error) {
+	rows, err := query.RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := &pb.ListCategoriesResponse{}
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.Categories = append(response.Categories, category)
+	}
+
+	return response, rows.Err()
+}
+
+func scanCategory(row squirrel.RowScanner) (*pb.Category, error) {
+	var (
+		category  pb.Category
+		parentId  sql.NullInt32
+		updatedAt sql.NullString
+	)
+
+	err := row.Scan(
+		&category.Id,
+		&parentId,
+		&category.Name,
+		&category.Slug,
+		&category.Position,
+		&category.CreatedAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	category.ParentId = parentId.Int32
+	category.UpdatedAt = updatedAt.String
+
+	return &category, nil
+}
+
+// nullID stores the zero id of a root category as NULL
+func nullID(id int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: id, Valid: id != 0}
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
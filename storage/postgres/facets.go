@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// facets aggregates the products matching where with one GROUP BY query per facet
+func (u *productRepo) facets(ctx context.Context, spec *repo.FacetSpec, where squirrel.Sqlizer) (*pb.Facets, error) {
+	counter := spec.Counter()
+
+	matching := u.db.Builder.Select("id").From("products").Where(where)
+	rows, err := u.db.Builder.Select("c.id", "c.name", "COUNT(*)").
+		From("products_categories pc").
+		Join("categories c ON c.id = pc.category_id").
+		Where(squirrel.Expr("pc.product_id IN (?)", matching)).
+		GroupBy("c.id", "c.name").
+		RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id    int32
+			name  string
+			count int64
+		)
+		if err := rows.Scan(&id, &name, &count); err != nil {
+			return nil, err
+		}
+		counter.AddCategory(id, name, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// width_bucket numbers the buckets of the lower bounds from 1
+	bounds := make([]float64, len(spec.Bounds))
+	for i, bound := range spec.Bounds {
+		bounds[i] = float64(bound)
+	}
+
+	buckets, err := u.db.Builder.Select().
+		Column("width_bucket(price, ?::float8[]) AS bucket", pq.Array(bounds)).
+		Column("COUNT(*)").
+		From("products").
+		Where(where).
+		GroupBy("bucket").
+		RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer buckets.Close()
+
+	for buckets.Next() {
+		var bucket, count int64
+		if err := buckets.Scan(&bucket, &count); err != nil {
+			return nil, err
+		}
+		counter.AddBucket(int(bucket)-1, count)
+	}
+	if err := buckets.Err(); err != nil {
+		return nil, err
+	}
+
+	var inStock, outOfStock int64
+	err = u.db.Builder.Select("COUNT(*) FILTER (WHERE "+available+" > 0)", "COUNT(*) FILTER (WHERE "+available+" = 0)").
+		From("products").
+		Where(where).
+		RunWith(u.db.DB).QueryRowContext(ctx).Scan(&inStock, &outOfStock)
+	if err != nil {
+		return nil, err
+	}
+	counter.AddStock(true, inStock)
+	counter.AddStock(false, outOfStock)
+
+	return counter.Facets(), nil
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// movementColumns is the column list scanMovement expects
+const movementColumns = `id, product_id, delta, reason, actor, reference, balance, created_at`
+
+func (u *productRepo) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	filter, err := repo.NewMovementFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	where := squirrel.And{squirrel.Eq{"product_id": filter.ProductId}}
+	if !filter.From.IsZero() {
+		where = append(where, squirrel.GtOrEq{"created_at": filter.From})
+	}
+	if !filter.To.IsZero() {
+		where = append(where, squirrel.Lt{"created_at": filter.To})
+	}
+
+	response := &pb.ListStockMovementsResponse{}
+	err = u.db.Builder.Select("COUNT(*)").From("stock_movements").Where(where).
+		RunWith(u.db.DB).QueryRowContext(ctx).Scan(&response.TotalCount)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := u.db.Builder.Select(movementColumns).From("stock_movements").Where(where).
+		OrderBy("id").
+		Offset(uint64(filter.Offset)).
+		Limit(uint64(filter.Limit)).
+		RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		movement, err := scanMovement(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.Movements = append(response.Movements, movement)
+	}
+
+	return response, rows.Err()
+}
+
+// ReconcileStock compares every product amount with the sum of its ledger
+// in one statement, soft-deleted products included
+func (u *productRepo) ReconcileStock(ctx context.Context, req *pb.ReconcileStockRequest) (*pb.ReconcileStockResponse, error) {
+	ledger := u.db.Builder.Select("product_id", "SUM(delta) AS total").
+		From("stock_movements").
+		GroupBy("product_id")
+
+	query := u.db.Builder.Select("p.id", "p.amount", "COALESCE(l.total, 0)").
+		From("products p").
+		JoinClause(ledger.Prefix("LEFT JOIN (").Suffix(") l ON l.product_id = p.id")).
+		OrderBy("p.id")
+	if req.ProductId != 0 {
+		query = query.Where(squirrel.Eq{"p.id": req.ProductId})
+	}
+
+	rows, err := query.RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := &pb.ReconcileStockResponse{}
+	for rows.Next() {
+		var discrepancy pb.StockDiscrepancy
+		if err := rows.Scan(&discrepancy.ProductId, &discrepancy.Amount, &discrepancy.LedgerAmount); err != nil {
+			return nil, err
+		}
+		response.Checked++
+		if discrepancy.Amount != discrepancy.LedgerAmount {
+			response.Discrepancies = append(response.Discrepancies, &discrepancy)
+		}
+	}
+
+	return response, rows.Err()
+}
+
+// recordMovement appends movement to the ledger through runner, which must be
+// the transaction that changed the amount; changes of 0 are not recorded
+func (u *productRepo) recordMovement(ctx context.Context, runner squirrel.BaseRunner, movement *pb.StockMovement) error {
+	if movement.Delta == 0 {
+		return nil
+	}
+
+	_, err := u.db.Builder.Insert("stock_movements").
+		Columns("product_id, delta, reason, actor, reference, balance").
+		Values(movement.ProductId, movement.Delta, movement.Reason, movement.Actor, movement.Reference, movement.Balance).
+		RunWith(runner).ExecContext(ctx)
+
+	return err
+}
+
+func scanMovement(row squirrel.RowScanner) (*pb.StockMovement, error) {
+	var movement pb.StockMovement
+	err := row.Scan(
+		&movement.Id,
+		&movement.ProductId,
+		&movement.Delta,
+		&movement.Reason,
+		&movement.Actor,
+		&movement.Reference,
+		&movement.Balance,
+		&movement.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &movement, nil
+}
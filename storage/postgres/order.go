@@ -0,0 +1,423 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/storage/repo"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// purchaseColumns is the users_products column list scanPurchase expects
+const purchaseColumns = `order_id, product_id, amount, returned, unit_price, created_at`
+
+// orderColumns is the column list scanOrder expects
+const orderColumns = `id, user_id, created_at, status, paid_at, shipped_at, delivered_at, cancelled_at, refunded_at`
+
+// CreateOrder takes stock for every line and records the order in one transaction
+func (u *productRepo) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.Order, error) {
+	lines, err := repo.MergeOrderLines(req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	var order *pb.Order
+	err = u.db.WithTx(ctx, func(tx *sql.Tx) (err error) {
+		order, _, err = u.placeOrder(ctx, tx, req.UserId, lines)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (u *productRepo) GetOrder(ctx context.Context, req *pb.GetOrderId) (*pb.Order, error) {
+	query := u.db.Builder.Select(orderColumns).From("orders").Where(squirrel.Eq{"id": req.OrderId})
+
+	order, err := scanOrder(query.RunWith(u.db.DB).QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.NotFound("order", req.OrderId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.loadItems(ctx, []*pb.Order{order}); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (u *productRepo) ListOrdersByUser(ctx context.Context, req *pb.ListOrdersByUserRequest) (*pb.ListOrdersResponse, error) {
+	where := squirrel.Eq{"user_id": req.UserId}
+
+	response := &pb.ListOrdersResponse{}
+	err := u.db.Builder.Select("COUNT(*)").From("orders").Where(where).
+		RunWith(u.db.DB).QueryRowContext(ctx).Scan(&response.TotalCount)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := u.db.Builder.Select(orderColumns).From("orders").Where(where).
+		OrderBy("id DESC").
+		Offset(uint64((req.Page - 1) * req.Limit)).
+		Limit(uint64(req.Limit)).
+		RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.Orders = append(response.Orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := u.loadItems(ctx, response.Orders); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// TransitionOrder locks the order row so concurrent transitions of the same
+// order are checked one after the other; cancelling puts the stock back
+func (u *productRepo) TransitionOrder(ctx context.Context, req *pb.TransitionOrderRequest) (*pb.Order, error) {
+	err := u.db.WithTx(ctx, func(tx *sql.Tx) error {
+		var status pb.OrderStatus
+		err := u.db.Builder.Select("status").From("orders").
+			Where(squirrel.Eq{"id": req.OrderId}).
+			Suffix("FOR UPDATE").
+			RunWith(tx).QueryRowContext(ctx).Scan(&status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.NotFound("order", req.OrderId)
+		}
+		if err != nil {
+			return err
+		}
+		if err := repo.CheckTransition(req.OrderId, status, req.Status); err != nil {
+			return err
+		}
+
+		_, err = u.db.Builder.Update("orders").
+			Set("status", req.Status).
+			Set(repo.StatusColumn(req.Status), time.Now()).
+			Where(squirrel.Eq{"id": req.OrderId}).
+			RunWith(tx).ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		if req.Status == pb.OrderStatus_ORDER_CANCELLED {
+			return u.restockOrder(ctx, tx, req.OrderId)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetOrder(ctx, &pb.GetOrderId{OrderId: req.OrderId})
+}
+
+// ReturnOrderItem locks the order and the returned line, so concurrent
+// returns and transitions of the same order are checked one after the other
+func (u *productRepo) ReturnOrderItem(ctx context.Context, req *pb.ReturnOrderItemRequest) (*pb.OrderReturn, error) {
+	var orderReturn *pb.OrderReturn
+	err := u.db.WithTx(ctx, func(tx *sql.Tx) error {
+		var status pb.OrderStatus
+		err := u.db.Builder.Select("status").From("orders").
+			Where(squirrel.Eq{"id": req.OrderId}).
+			Suffix("FOR UPDATE").
+			RunWith(tx).QueryRowContext(ctx).Scan(&status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.NotFound("order", req.OrderId)
+		}
+		if err != nil {
+			return err
+		}
+		if err := repo.CheckReturnable(req.OrderId, status); err != nil {
+			return err
+		}
+
+		line := squirrel.Eq{"order_id": req.OrderId, "product_id": req.ProductId}
+		purchase, err := scanPurchase(u.db.Builder.Select(purchaseColumns).From("users_products").
+			Where(line).
+			Suffix("FOR UPDATE").
+			RunWith(tx).QueryRowContext(ctx))
+		if errors.Is(err, sql.ErrNoRows) {
+			return repo.OrderItemNotFound(req.OrderId, req.ProductId)
+		}
+		if err != nil {
+			return err
+		}
+		if err := repo.CheckReturnQuantity(purchase, req.Quantity); err != nil {
+			return err
+		}
+
+		if req.Restock {
+			err := u.restock(ctx, tx, req.ProductId, req.Quantity, pb.StockMovementReason_MOVEMENT_RETURN, repo.OrderRef(req.OrderId))
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = u.db.Builder.Update("users_products").
+			Set("returned", squirrel.Expr("returned + ?", req.Quantity)).
+			Where(line).
+			RunWith(tx).ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		orderReturn = repo.NewOrderReturn(req, purchase)
+		return u.db.Builder.Insert("order_returns").
+			Columns("order_id, product_id, quantity, reason, restocked, refund_amount").
+			Values(orderReturn.OrderId, orderReturn.ProductId, orderReturn.Quantity,
+				orderReturn.Reason, orderReturn.Restocked, orderReturn.RefundAmount).
+			Suffix("RETURNING id, created_at").
+			RunWith(tx).QueryRowContext(ctx).Scan(&orderReturn.Id, &orderReturn.CreatedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orderReturn, nil
+}
+
+// placeOrder takes stock for lines, which must be merged and sorted by
+// MergeOrderLines, and records them as one order. It returns the order and
+// the updated products in line order.
+func (u *productRepo) placeOrder(ctx context.Context, tx *sql.Tx, userId string, lines []*pb.OrderLine) (*pb.Order, []*pb.Product, error) {
+	order, err := scanOrder(u.db.Builder.Insert("orders").
+		Columns("user_id").
+		Values(userId).
+		Suffix("RETURNING " + orderColumns).
+		RunWith(tx).QueryRowContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	products := make([]*pb.Product, 0, len(lines))
+	for _, line := range lines {
+		product, err := u.takeStock(ctx, tx, line.ProductId, line.Quantity)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = u.recordMovement(ctx, tx, repo.NewMovement(ctx, product.Id, -line.Quantity, product.Amount,
+			pb.StockMovementReason_MOVEMENT_SALE, repo.OrderRef(order.Id)))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		_, err = u.db.Builder.Insert("users_products").
+			Columns("order_id, user_id, product_id, amount, unit_price, created_at").
+			Values(order.Id, userId, line.ProductId, line.Quantity, product.Price, order.CreatedAt).
+			RunWith(tx).ExecContext(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		order.Items = append(order.Items, &pb.OrderItem{
+			ProductId: line.ProductId,
+			Quantity:  line.Quantity,
+			UnitPrice: product.Price,
+		})
+		products = append(products, product)
+	}
+	repo.SetOrderTotals(order)
+
+	return order, products, nil
+}
+
+// takeStock removes quantity from a live product, failing when there is not enough
+func (u *productRepo) takeStock(ctx context.Context, tx *sql.Tx, productId, quantity int32) (*pb.Product, error) {
+	query := u.db.Builder.Update("products").
+		Set("amount", squirrel.Expr("amount - ?", quantity)).
+		Set("updated_at", time.Now()).
+		Set("version", nextVersion).
+		Where(squirrel.And{
+			squirrel.Eq{"id": productId},
+			squirrel.Expr(available+" >= ?", quantity),
+			notDeleted,
+		}).
+		Suffix("RETURNING " + productColumns)
+
+	product, err := scanProduct(query.RunWith(tx).QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		check, err := u.CheckAmount(ctx, &pb.GetProductId{ProductId: productId})
+		if err != nil {
+			return nil, err
+		}
+		return nil, errs.InsufficientStock(productId, check.Amount, quantity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// restockOrder puts the items of an order back in stock, in product id
+// order like placeOrder. Returned units were restocked, or not, by the
+// return itself. Soft-deleted products get their stock back too.
+func (u *productRepo) restockOrder(ctx context.Context, tx *sql.Tx, orderId int32) error {
+	rows, err := u.db.Builder.Select("product_id, amount - returned").
+		From("users_products").
+		Where(squirrel.And{squirrel.Eq{"order_id": orderId}, squirrel.Expr("amount > returned")}).
+		OrderBy("product_id").
+		RunWith(tx).QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var lines []*pb.OrderLine
+	for rows.Next() {
+		var line pb.OrderLine
+		if err := rows.Scan(&line.ProductId, &line.Quantity); err != nil {
+			rows.Close()
+			return err
+		}
+		lines = append(lines, &line)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		err := u.restock(ctx, tx, line.ProductId, line.Quantity, pb.StockMovementReason_MOVEMENT_CANCELLATION, repo.OrderRef(orderId))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restock puts quantity back in stock and records it in the ledger. Unlike
+// increase it also restocks soft-deleted products, which orders may still
+// hold.
+func (u *productRepo) restock(ctx context.Context, tx *sql.Tx, productId, quantity int32, reason pb.StockMovementReason, ref string) error {
+	var balance int32
+	err := u.db.Builder.Update("products").
+		Set("amount", squirrel.Expr("amount + ?", quantity)).
+		Set("updated_at", time.Now()).
+		Set("version", nextVersion).
+		Where(squirrel.Eq{"id": productId}).
+		Suffix("RETURNING amount").
+		RunWith(tx).QueryRowContext(ctx).Scan(&balance)
+	if err != nil {
+		return err
+	}
+
+	return u.recordMovement(ctx, tx, repo.NewMovement(ctx, productId, quantity, balance, reason, ref))
+}
+
+// loadItems fetches the items of all orders in one query
+func (u *productRepo) loadItems(ctx context.Context, orders []*pb.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byId := make(map[int32]*pb.Order, len(orders))
+	ids := make([]int32, 0, len(orders))
+	for _, order := range orders {
+		byId[order.Id] = order
+		ids = append(ids, order.Id)
+	}
+
+	rows, err := u.db.Builder.Select("order_id, product_id, amount, unit_price").
+		From("users_products").
+		Where("order_id = ANY(?)", pq.Array(ids)).
+		OrderBy("order_id", "product_id").
+		RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderId int32
+			item    pb.OrderItem
+		)
+		if err := rows.Scan(&orderId, &item.ProductId, &item.Quantity, &item.UnitPrice); err != nil {
+			return err
+		}
+		order := byId[orderId]
+		order.Items = append(order.Items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		repo.SetOrderTotals(order)
+	}
+
+	return nil
+}
+
+func scanOrder(row squirrel.RowScanner) (*pb.Order, error) {
+	var (
+		order                          pb.Order
+		paidAt, shippedAt, deliveredAt sql.NullString
+		cancelledAt, refundedAt        sql.NullString
+	)
+
+	err := row.Scan(
+		&order.Id,
+		&order.UserId,
+		&order.CreatedAt,
+		&order.Status,
+		&paidAt,
+		&shippedAt,
+		&deliveredAt,
+		&cancelledAt,
+		&refundedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	order.PaidAt = paidAt.String
+	order.ShippedAt = shippedAt.String
+	order.DeliveredAt = deliveredAt.String
+	order.CancelledAt = cancelledAt.String
+	order.RefundedAt = refundedAt.String
+
+	return &order, nil
+}
+
+func scanPurchase(row squirrel.RowScanner) (*pb.Purchase, error) {
+	var purchase pb.Purchase
+	err := row.Scan(
+		&purchase.OrderId,
+		&purchase.ProductId,
+		&purchase.Quantity,
+		&purchase.ReturnedQuantity,
+		&purchase.UnitPrice,
+		&purchase.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	purchase.NetQuantity = purchase.Quantity - purchase.ReturnedQuantity
+
+	return &purchase, nil
+}
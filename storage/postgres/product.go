@@ -2,14 +2,32 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	pb "exam/product-service/genproto/product-service"
 	"exam/product-service/pkg/db"
+	"exam/product-service/pkg/errs"
 	"exam/product-service/pkg/logger"
 	"exam/product-service/storage/repo"
-	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// productColumns is the column list scanProduct expects
+const productColumns = `id, name, description, price, amount, created_at, updated_at, deleted_at, version`
+
+var (
+	// notDeleted hides soft-deleted products
+	notDeleted = squirrel.Eq{"deleted_at": nil}
+	// nextVersion bumps the product version on every write
+	nextVersion = squirrel.Expr("version + 1")
+	// available is the stock not held by reservations
+	available = "GREATEST(amount - reserved, 0)"
+	// likeEscaper makes user input match literally inside a LIKE pattern
+	likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
 )
 
 type productRepo struct {
@@ -33,9 +51,14 @@ func (u *productRepo) CreateProduct(ctx context.Context, req *pb.Product) (*pb.P
 		Values(
 			req.Name, req.Description, req.Price, req.Amount,
 		).
-		Suffix("RETURNING id, created_at")
+		Suffix("RETURNING id, created_at, version")
 
-	err := query.RunWith(u.db.DB).QueryRow().Scan(&req.Id, &req.CreatedAt)
+	err := u.db.WithTx(ctx, func(tx *sql.Tx) error {
+		if err := query.RunWith(tx).QueryRowContext(ctx).Scan(&req.Id, &req.CreatedAt, &req.Version); err != nil {
+			return err
+		}
+		return u.recordMovement(ctx, tx, repo.NewMovement(ctx, req.Id, req.Amount, req.Amount, pb.StockMovementReason_MOVEMENT_OPENING, ""))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -44,20 +67,17 @@ func (u *productRepo) CreateProduct(ctx context.Context, req *pb.Product) (*pb.P
 }
 
 func (u *productRepo) GetProductById(ctx context.Context, req *pb.GetProductId) (*pb.Product, error) {
-	respProduct := &pb.Product{}
-
-	query := u.db.Builder.Select(`
-		id, name, description, price, amount, created_at
-	`).From("products").Where(squirrel.Eq{"id": req.ProductId})
-
-	err := query.RunWith(u.db.DB).QueryRow().Scan(
-		&respProduct.Id,
-		&respProduct.Name,
-		&respProduct.Description,
-		&respProduct.Price,
-		&respProduct.Amount,
-		&respProduct.CreatedAt,
-	)
+	where := squirrel.And{squirrel.Eq{"id": req.ProductId}}
+	if !req.IncludeDeleted {
+		where = append(where, notDeleted)
+	}
+
+	query := u.db.Builder.Select(productColumns).From("products").Where(where)
+
+	respProduct, err := scanProduct(query.RunWith(u.db.DB).QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -65,203 +85,299 @@ func (u *productRepo) GetProductById(ctx context.Context, req *pb.GetProductId)
 	return respProduct, nil
 }
 
-func (u *productRepo) UpdateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error) {
+// GetProductsByIds fetches every requested product in one query
+func (u *productRepo) GetProductsByIds(ctx context.Context, req *pb.GetProductsByIdsRequest) (*pb.GetProductsByIdsResponse, error) {
+	if len(req.ProductIds) == 0 {
+		return &pb.GetProductsByIdsResponse{}, nil
+	}
+
+	where := squirrel.And{squirrel.Expr("id = ANY(?)", pq.Array(req.ProductIds))}
+	if !req.IncludeDeleted {
+		where = append(where, notDeleted)
+	}
+
+	rows, err := u.db.Builder.Select(productColumns).From("products").Where(where).
+		RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	found := make(map[int32]*pb.Product, len(req.ProductIds))
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		found[product.Id] = product
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return repo.InRequestOrder(req.ProductIds, found), nil
+}
+
+// UpdateProduct writes only the fields named in the update mask. A non-zero
+// product version must match the stored one.
+func (u *productRepo) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	paths, err := repo.UpdateMaskPaths(req)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		updateMap = make(map[string]interface{})
-		where     = squirrel.And{squirrel.Eq{"id": req.Id}}
+		where     = squirrel.And{squirrel.Eq{"id": req.Product.Id}, notDeleted}
 	)
 
-	updateMap["name"] = req.Name
-	updateMap["description"] = req.Description
-	updateMap["price"] = req.Price
-	updateMap["amount"] = req.Amount
+	for _, path := range paths {
+		updateMap[path] = repo.ProductFieldValue(req.Product, path)
+	}
 	updateMap["updated_at"] = time.Now()
+	updateMap["version"] = nextVersion
 
 	query := u.db.Builder.Update("products").SetMap(updateMap).
-		Where(where).
-		Suffix("RETURNING updated_at, created_at")
+		Where(withVersion(where, req.Product.Version)).
+		Suffix("RETURNING " + productColumns)
+
+	var product *pb.Product
+	err = u.db.WithTx(ctx, func(tx *sql.Tx) error {
+		// the amount before the update is locked, so the ledger sees every change
+		var before int32
+		_, setsAmount := updateMap["amount"]
+		if setsAmount {
+			err := u.db.Builder.Select("amount").From("products").Where(where).Suffix("FOR UPDATE").
+				RunWith(tx).QueryRowContext(ctx).Scan(&before)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+		}
 
-	err := query.RunWith(u.db.DB).QueryRow().Scan(
-		&req.UpdatedAt, &req.CreatedAt,
-	)
+		var err error
+		product, err = scanProduct(query.RunWith(tx).QueryRowContext(ctx))
+		if errors.Is(err, sql.ErrNoRows) {
+			if _, err := u.current(ctx, req.Product.Id, req.Product.Version); err != nil {
+				return err
+			}
+			return errs.Conflict("product %d changed concurrently, retry", req.Product.Id)
+		}
+		if err != nil || !setsAmount {
+			return err
+		}
+
+		return u.recordMovement(ctx, tx, repo.NewMovement(ctx, product.Id, product.Amount-before, product.Amount,
+			pb.StockMovementReason_MOVEMENT_ADJUSTMENT, ""))
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
+	return product, nil
 }
 
+// DeleteProduct only marks the product deleted, so purchases that reference
+// it keep their history; PurgeDeletedProducts removes it for good unless
+// it was ever bought.
 func (u *productRepo) DeleteProduct(ctx context.Context, req *pb.GetProductId) (*pb.Status, error) {
-	query := u.db.Builder.Delete("products").Where(
-		squirrel.Eq{"id": req.ProductId},
-	)
+	query := u.db.Builder.Update("products").
+		Set("deleted_at", time.Now()).
+		Set("version", nextVersion).
+		Where(squirrel.And{squirrel.Eq{"id": req.ProductId}, notDeleted})
+
+	result, err := query.RunWith(u.db.DB).ExecContext(ctx)
+	if err != nil {
+		return &pb.Status{
+			Success: false,
+		}, err
+	}
 
-	_, err := query.RunWith(u.db.DB).Exec()
+	deleted, err := result.RowsAffected()
 	if err != nil {
 		return &pb.Status{
 			Success: false,
 		}, err
 	}
+	if deleted == 0 {
+		return &pb.Status{
+			Success: false,
+		}, errs.NotFound("product", req.ProductId)
+	}
 
 	return &pb.Status{
 		Success: true,
 	}, nil
 }
 
+// ListProducts applies the request filters and sort order, breaking ties
+// by id so pages never overlap. A page token switches from OFFSET to a
+// keyset condition on (sort column, id).
 func (u *productRepo) ListProducts(ctx context.Context, req *pb.GetListRequest) (*pb.GetListResponse, error) {
 	var (
 		respProducts = &pb.GetListResponse{Count: 0}
 	)
 
-	query := u.db.Builder.Select(
-		`id, name, description, price, amount, created_at
-	`).From("products")
+	filter, err := repo.NewListFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	direction, comparison := " ASC", ">"
+	if filter.Descending {
+		direction, comparison = " DESC", "<"
+	}
 
-	query = query.Offset(uint64((req.Page - 1) * req.Limit)).Limit(uint64(req.Limit))
+	where := listWhere(req, filter)
 
-	rows, err := query.RunWith(u.db.DB).Query()
+	var total int64
+	err = u.db.Builder.Select("COUNT(*)").From("products").Where(where).
+		RunWith(u.db.DB).QueryRowContext(ctx).Scan(&total)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		respProduct := &pb.Product{}
-		err = rows.Scan(
-			&respProduct.Id,
-			&respProduct.Name,
-			&respProduct.Description,
-			&respProduct.Price,
-			&respProduct.Amount,
-			&respProduct.CreatedAt,
-		)
-		if err != nil {
+	if filter.Facets != nil {
+		if respProducts.Facets, err = u.facets(ctx, filter.Facets, where); err != nil {
 			return nil, err
 		}
-		respProducts.Products = append(respProducts.Products, respProduct)
-		respProducts.Count++
 	}
 
-	return respProducts, nil
-}
+	column := filter.SortColumn()
+	if filter.After != nil {
+		where = append(where, squirrel.Expr("("+column+", id) "+comparison+" (?, ?)", filter.After.Key, filter.After.Id))
+	}
 
-func (u *productRepo) IncreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
-	var (
-		response  = &pb.ProductAmountResponse{Product: &pb.Product{}}
-		updateMap = make(map[string]interface{})
-		where     = squirrel.And{squirrel.Eq{"id": req.ProductId}}
-	)
+	query := u.db.Builder.Select(productColumns).From("products").
+		Where(where).
+		OrderBy(column+direction, "id"+direction)
 
-	var currentAmount int32
-	query := u.db.Builder.Select("amount").From("products").Where(squirrel.Eq{"id": req.ProductId})
+	// one extra row tells whether there is a next page
+	query = query.Offset(uint64(filter.Offset)).Limit(uint64(filter.Limit) + 1)
 
-	err := query.RunWith(u.db.DB).QueryRow().Scan(
-		&currentAmount,
-	)
+	rows, err := query.RunWith(u.db.DB).QueryContext(ctx)
 	if err != nil {
-		return &pb.ProductAmountResponse{
-			IsEnough: false,
-			Product:  nil,
-		}, err
+		return nil, err
 	}
+	defer rows.Close()
 
-	updateMap["amount"] = req.AmountBy + currentAmount
-	updateMap["updated_at"] = time.Now()
-	response.Product.Amount = req.AmountBy + currentAmount
-
-	query2 := u.db.Builder.Update("products").SetMap(updateMap).
-		Where(where).
-		Suffix("RETURNING id, name, description, price, amount, created_at")
-
-	err = query2.RunWith(u.db.DB).QueryRow().Scan(
-		&response.Product.Id,
-		&response.Product.Name,
-		&response.Product.Description,
-		&response.Product.Price,
-		&response.Product.Amount,
-		&response.Product.CreatedAt,
-	)
-	if err != nil {
-		return &pb.ProductAmountResponse{
-			IsEnough: false,
-			Product:  nil,
-		}, err
+	var products []*pb.Product
+	for rows.Next() {
+		respProduct, err := scanProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, respProduct)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	response.IsEnough = true
+	filter.Fill(respProducts, products, total)
 
-	return response, nil
+	return respProducts, nil
 }
 
-func (u *productRepo) DecreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
-	var (
-		response  = &pb.ProductAmountResponse{Product: &pb.Product{}}
-		updateMap = make(map[string]interface{})
-		where     = squirrel.And{squirrel.Eq{"id": req.ProductId}}
-	)
-
-	var currentAmount int32
-	query := u.db.Builder.Select("amount").From("products").Where(squirrel.Eq{"id": req.ProductId})
-
-	err := query.RunWith(u.db.DB).QueryRow().Scan(
-		&currentAmount,
-	)
+func (u *productRepo) IncreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
+	var product *pb.Product
+	err := u.db.WithTx(ctx, func(tx *sql.Tx) (err error) {
+		if product, err = u.increase(ctx, tx, req); err != nil {
+			return err
+		}
+		return u.recordMovement(ctx, tx, repo.NewMovement(ctx, product.Id, req.AmountBy, product.Amount,
+			pb.StockMovementReason_MOVEMENT_INCREASE, ""))
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	response.IsEnough = true
+	return &pb.ProductAmountResponse{IsEnough: true, Product: product}, nil
+}
 
-	if currentAmount == 0 {
-		return nil, fmt.Errorf("not enough")
+// increase adds stock to a live product through runner, the database or a
+// transaction
+func (u *productRepo) increase(ctx context.Context, runner squirrel.BaseRunner, req *pb.ProductAmountRequest) (*pb.Product, error) {
+	query := u.db.Builder.Update("products").
+		Set("amount", squirrel.Expr("amount + ?", req.AmountBy)).
+		Set("updated_at", time.Now()).
+		Set("version", nextVersion).
+		Where(withVersion(squirrel.And{squirrel.Eq{"id": req.ProductId}, notDeleted}, req.ExpectedVersion)).
+		Suffix("RETURNING " + productColumns)
+
+	product, err := scanProduct(query.RunWith(runner).QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, err := u.current(ctx, req.ProductId, req.ExpectedVersion); err != nil {
+			return nil, err
+		}
+		return nil, errs.Conflict("product %d changed concurrently, retry", req.ProductId)
 	}
-
-	// changeTo := currentAmount - req.AmountBy
-	// if changeTo < 0 {
-	// 	changeTo = currentAmount
-	// 	response.IsEnough = false
-	// }
-	if currentAmount-req.AmountBy < 0 {
-		response.IsEnough = false
+	if err != nil {
 		return nil, err
 	}
 
-	changeTo := currentAmount - req.AmountBy
+	return product, nil
+}
 
-	updateMap["amount"] = changeTo
-	updateMap["updated_at"] = time.Now()
+// DecreaseProductAmount takes stock in a single guarded statement, so
+// concurrent callers can never drive the amount below zero.
+func (u *productRepo) DecreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error) {
+	query := u.db.Builder.Update("products").
+		Set("amount", squirrel.Expr("amount - ?", req.AmountBy)).
+		Set("updated_at", time.Now()).
+		Set("version", nextVersion).
+		Where(withVersion(squirrel.And{
+			squirrel.Eq{"id": req.ProductId},
+			squirrel.Expr(available+" >= ?", req.AmountBy),
+			notDeleted,
+		}, req.ExpectedVersion)).
+		Suffix("RETURNING " + productColumns)
+
+	var response *pb.ProductAmountResponse
+	err := u.db.WithTx(ctx, func(tx *sql.Tx) error {
+		product, err := scanProduct(query.RunWith(tx).QueryRowContext(ctx))
+		if errors.Is(err, sql.ErrNoRows) {
+			// Nothing matched: the product is missing, was modified or the guard failed.
+			product, err := u.current(ctx, req.ProductId, req.ExpectedVersion)
+			if err != nil {
+				return err
+			}
+			check, err := u.CheckAmount(ctx, &pb.GetProductId{ProductId: req.ProductId})
+			if err != nil {
+				return err
+			}
+
+			response = &pb.ProductAmountResponse{IsEnough: false, Product: product}
+			return errs.InsufficientStock(product.Id, check.Amount, req.AmountBy)
+		}
+		if err != nil {
+			return err
+		}
 
-	query2 := u.db.Builder.Update("products").SetMap(updateMap).
-		Where(where).
-		Suffix("RETURNING id, name, description, price, amount, created_at")
-
-	err = query2.RunWith(u.db.DB).QueryRow().Scan(
-		&response.Product.Id,
-		&response.Product.Name,
-		&response.Product.Description,
-		&response.Product.Price,
-		&response.Product.Amount,
-		&response.Product.CreatedAt,
-	)
-	if err != nil {
-		response.IsEnough = false
-		return response, err
-	}
+		err = u.recordMovement(ctx, tx, repo.NewMovement(ctx, product.Id, -req.AmountBy, product.Amount,
+			pb.StockMovementReason_MOVEMENT_DECREASE, ""))
+		if err != nil {
+			return err
+		}
+		response = &pb.ProductAmountResponse{IsEnough: true, Product: product}
+		return nil
+	})
 
-	response.Product.Amount = changeTo
-	return response, nil
+	return response, err
 }
 
 func (u *productRepo) CheckAmount(ctx context.Context, req *pb.GetProductId) (*pb.CheckAmountResponse, error) {
 	var checkResult pb.CheckAmountResponse
-	query := u.db.Builder.Select("amount").From("products").Where(
-		squirrel.Eq{"id": req.ProductId},
+	query := u.db.Builder.Select(available, "reserved").From("products").Where(
+		squirrel.And{squirrel.Eq{"id": req.ProductId}, notDeleted},
 	)
 
-	err := query.RunWith(u.db.DB).QueryRow().Scan(
+	err := query.RunWith(u.db.DB).QueryRowContext(ctx).Scan(
 		&checkResult.Amount,
+		&checkResult.Reserved,
 	)
 	checkResult.ProductId = req.ProductId
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -269,57 +385,189 @@ func (u *productRepo) CheckAmount(ctx context.Context, req *pb.GetProductId) (*p
 	return &checkResult, nil
 }
 
+// BuyProduct places an order with a single line and returns the product
 func (u *productRepo) BuyProduct(ctx context.Context, req *pb.BuyProductRequest) (*pb.Product, error) {
-	query := u.db.Builder.Insert("users_products").
-		Columns("user_id, product_id, amount").
-		Values(req.UserId, req.ProductId, req.Amount)
-
-	_, err := query.RunWith(u.db.DB).Exec()
+	lines, err := repo.MergeOrderLines([]*pb.OrderLine{{ProductId: req.ProductId, Quantity: req.Amount}})
 	if err != nil {
 		return nil, err
 	}
 
-	product, err := u.GetProductById(ctx, &pb.GetProductId{
-		ProductId: req.ProductId,
+	var products []*pb.Product
+	err = u.db.WithTx(ctx, func(tx *sql.Tx) (err error) {
+		_, products, err = u.placeOrder(ctx, tx, req.UserId, lines)
+		return err
 	})
-
 	if err != nil {
 		return nil, err
 	}
 
-	return product, nil
+	return products[0], nil
 }
 
 func (u *productRepo) GetPurchasedProductsByUserId(ctx context.Context, req *pb.GetUserID) (*pb.GetPurchasedProductsResponse, error) {
-	query := u.db.Builder.Select("product_id").
+	query := u.db.Builder.Select(purchaseColumns).
 		From("users_products").
-		Where(squirrel.Eq{"user_id": req.UserId})
-	rows, err := query.RunWith(u.db.DB).Query()
+		Where(squirrel.Eq{"user_id": req.UserId}).
+		OrderBy("order_id", "product_id")
+	rows, err := query.RunWith(u.db.DB).QueryContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	var products []*pb.Product
-
+	response := &pb.GetPurchasedProductsResponse{}
+	var ids []int32
 	for rows.Next() {
-		var id int32
-		if err := rows.Scan(&id); err != nil {
-			return nil, err
-		}
-		product, err := u.GetProductById(ctx, &pb.GetProductId{ProductId: id})
+		purchase, err := scanPurchase(rows)
 		if err != nil {
 			return nil, err
 		}
-		products = append(products, product)
+		response.Purchases = append(response.Purchases, purchase)
+		ids = append(ids, purchase.ProductId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	response := &pb.GetPurchasedProductsResponse{
-		Products: products,
+	// Purchase history outlives soft deletion of the product
+	products, err := u.GetProductsByIds(ctx, &pb.GetProductsByIdsRequest{ProductIds: ids, IncludeDeleted: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(products.MissingIds) > 0 {
+		return nil, errs.NotFound("product", products.MissingIds[0])
 	}
+	response.Products = products.Products
 
 	return response, nil
 }
 
-//userId -> many-to-many ([]product_ids) -> for _, id product_id {
-//	productInfo, err := GetProductById(id)
-//}
+// RestoreProduct clears the deletion mark; restoring a live product is a no-op
+func (u *productRepo) RestoreProduct(ctx context.Context, req *pb.GetProductId) (*pb.Product, error) {
+	query := u.db.Builder.Update("products").
+		Set("deleted_at", nil).
+		Set("version", nextVersion).
+		Where(squirrel.Eq{"id": req.ProductId}).
+		Suffix("RETURNING " + productColumns)
+
+	product, err := scanProduct(query.RunWith(u.db.DB).QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.NotFound("product", req.ProductId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+func (u *productRepo) PurgeDeletedProducts(ctx context.Context, req *pb.PurgeDeletedProductsRequest) (*pb.PurgeDeletedProductsResponse, error) {
+	olderThan, err := time.Parse(time.RFC3339, req.OlderThan)
+	if err != nil {
+		return nil, errs.InvalidArgument("older_than: %v", err)
+	}
+
+	query := u.db.Builder.Delete("products").Where(squirrel.And{
+		squirrel.NotEq{"deleted_at": nil},
+		squirrel.Lt{"deleted_at": olderThan},
+		// purchase history must outlive the purge
+		squirrel.Expr("NOT EXISTS (SELECT 1 FROM users_products up WHERE up.product_id = products.id)"),
+	})
+
+	result, err := query.RunWith(u.db.DB).ExecContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PurgeDeletedProductsResponse{Purged: purged}, nil
+}
+
+// current explains why a guarded write matched nothing: the product is
+// missing or its version moved past expectedVersion. Otherwise it returns
+// the product as it is now.
+func (u *productRepo) current(ctx context.Context, id int32, expectedVersion int64) (*pb.Product, error) {
+	product, err := u.GetProductById(ctx, &pb.GetProductId{ProductId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedVersion != 0 && product.Version != expectedVersion {
+		return nil, errs.VersionMismatch("product", id, expectedVersion, product.Version)
+	}
+
+	return product, nil
+}
+
+// withVersion adds the optimistic concurrency guard when a version is expected
+func withVersion(where squirrel.And, expectedVersion int64) squirrel.And {
+	if expectedVersion == 0 {
+		return where
+	}
+
+	return append(where, squirrel.Eq{"version": expectedVersion})
+}
+
+// listWhere translates the filters of a list request into a WHERE clause
+func listWhere(req *pb.GetListRequest, filter *repo.ListFilter) squirrel.And {
+	where := squirrel.And{}
+	if !req.IncludeDeleted {
+		where = append(where, notDeleted)
+	}
+	if req.CategoryId != 0 {
+		where = append(where, squirrel.Expr(inCategoryTree, req.CategoryId))
+	}
+	if filter.Name != "" {
+		where = append(where, squirrel.ILike{"name": "%" + likeEscaper.Replace(filter.Name) + "%"})
+	}
+	if filter.MinPrice > 0 {
+		where = append(where, squirrel.GtOrEq{"price": filter.MinPrice})
+	}
+	if filter.MaxPrice > 0 {
+		where = append(where, squirrel.LtOrEq{"price": filter.MaxPrice})
+	}
+	if filter.InStock {
+		where = append(where, squirrel.Expr(available+" > 0"))
+	}
+	if !filter.CreatedAfter.IsZero() {
+		where = append(where, squirrel.GtOrEq{"created_at": filter.CreatedAfter})
+	}
+	if !filter.CreatedBefore.IsZero() {
+		where = append(where, squirrel.Lt{"created_at": filter.CreatedBefore})
+	}
+
+	return where
+}
+
+// scanProduct reads productColumns followed by any extra columns into extra
+func scanProduct(row squirrel.RowScanner, extra ...interface{}) (*pb.Product, error) {
+	var (
+		product   pb.Product
+		updatedAt sql.NullString
+		deletedAt sql.NullString
+	)
+
+	err := row.Scan(append([]interface{}{
+		&product.Id,
+		&product.Name,
+		&product.Description,
+		&product.Price,
+		&product.Amount,
+		&product.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+		&product.Version,
+	}, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	product.UpdatedAt = updatedAt.String
+	product.Deleted = deletedAt.String
+
+	return &product, nil
+}
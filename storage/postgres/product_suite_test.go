@@ -4,9 +4,13 @@ import (
 	"context"
 	"exam/product-service/config"
 	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/migrations"
 	db2 "exam/product-service/pkg/db"
+	"exam/product-service/pkg/errs"
 	"exam/product-service/pkg/logger"
+	"exam/product-service/pkg/migrate"
 	"exam/product-service/storage/repo"
+	"exam/product-service/storage/repotest"
 	"testing"
 	"time"
 
@@ -22,7 +26,7 @@ type ProductTestSuite struct {
 }
 
 func (u *ProductTestSuite) SetupSuite() {
-	db, _ := db2.New(*config.Load())
+	db := connect(u.T())
 	u.Repository = NewProductRepo(db, logger.New("", ""))
 	u.CleanupFunc = db.Close
 }
@@ -68,7 +72,7 @@ func (u *ProductTestSuite) TestPositionCRUD() {
 	product.Name = updatedName
 	updatedDescription := gofakeit.ProductDescription()
 	product.Description = updatedDescription
-	updateResp, err := u.Repository.UpdateProduct(ctx, product)
+	updateResp, err := u.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{Product: product})
 	u.Suite.NoError(err)
 	u.Suite.NotNil(updateResp)
 	u.Suite.Equal(updatedName, updateResp.Name)
@@ -91,6 +95,15 @@ func (u *ProductTestSuite) TestPositionCRUD() {
 	u.Suite.NotNil(productResp)
 	u.Suite.Equal(productResp.Name, product.Name)
 	u.Suite.Equal(productResp.Description, product.Description)
+	u.Suite.Equal(product.Amount-1, productResp.Amount)
+
+	//Buy more than in stock
+	_, err = u.Repository.BuyProduct(ctx, &pb.BuyProductRequest{
+		UserId:    userId,
+		ProductId: productId.ProductId,
+		Amount:    productResp.Amount + 1,
+	})
+	u.Suite.ErrorIs(err, errs.ErrInsufficientStock)
 
 	//Increase product
 	response, err := u.Repository.IncreaseProductAmount(ctx, &pb.ProductAmountRequest{
@@ -109,7 +122,7 @@ func (u *ProductTestSuite) TestPositionCRUD() {
 	})
 	u.Suite.NoError(err)
 	u.Suite.NotNil(resp)
-	u.Suite.Equal(resp.Product.Amount, product.Amount)
+	u.Suite.Equal(resp.Product.Amount, product.Amount-1)
 	u.Suite.Equal(resp.IsEnough, true)
 	u.Suite.Equal(resp.Product.Price, product.Price)
 
@@ -125,3 +138,37 @@ func (u *ProductTestSuite) TearDownSuite() {
 func TestUserRepository(t *testing.T) {
 	suite.Run(t, new(ProductTestSuite))
 }
+
+func TestConformance(t *testing.T) {
+	db := connect(t)
+	defer db.Close()
+
+	repotest.Run(t, func(*testing.T) (repo.ProductServiceI, func()) {
+		return NewProductRepo(db, logger.New("", "")), func() {}
+	})
+}
+
+// connect opens the configured database and skips the test when it is not
+// reachable, so the suite only runs where Postgres is available
+func connect(t *testing.T) *db2.Postgres {
+	db, err := db2.New(*config.Load())
+	if err != nil {
+		t.Skipf("postgres is not configured: %v", err)
+	}
+
+	if err := db.DB.Ping(); err != nil {
+		db.Close()
+		t.Skipf("postgres is not reachable: %v", err)
+	}
+
+	driver, err := migrate.NewPostgres(db, migrations.FS)
+	if err == nil {
+		err = migrate.New(driver, logger.New("", "")).Up(context.Background())
+	}
+	if err != nil {
+		db.Close()
+		t.Fatalf("cannot migrate: %v", err)
+	}
+
+	return db
+}
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/storage/repo"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// reservationColumns is the column list scanReservation expects
+const reservationColumns = `id, product_id, quantity, status, expires_at, created_at`
+
+// sweepBatchSize bounds the reservations ReleaseExpiredReservations
+// releases per transaction
+const sweepBatchSize = 500
+
+// ReserveStock holds stock with a single guarded statement, so concurrent
+// reservations can never hold more than is in stock
+func (u *productRepo) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.Reservation, error) {
+	now := time.Now().UTC()
+
+	var reservation *pb.Reservation
+	err := u.db.WithTx(ctx, func(tx *sql.Tx) error {
+		res, err := u.db.Builder.Update("products").
+			Set("reserved", squirrel.Expr("reserved + ?", req.Quantity)).
+			Where(squirrel.And{
+				squirrel.Eq{"id": req.ProductId},
+				squirrel.Expr(available+" >= ?", req.Quantity),
+				notDeleted,
+			}).
+			RunWith(tx).ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			check, err := u.CheckAmount(ctx, &pb.GetProductId{ProductId: req.ProductId})
+			if err != nil {
+				return err
+			}
+			return errs.InsufficientStock(req.ProductId, check.Amount, req.Quantity)
+		}
+
+		reservation, err = scanReservation(u.db.Builder.Insert("stock_reservations").
+			Columns("product_id, quantity, expires_at, created_at").
+			Values(req.ProductId, req.Quantity, repo.ReservationExpiry(req, now), now).
+			Suffix("RETURNING " + reservationColumns).
+			RunWith(tx).QueryRowContext(ctx))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func (u *productRepo) CommitReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error) {
+	return u.settle(ctx, req.ReservationId, pb.ReservationStatus_RESERVATION_COMMITTED)
+}
+
+func (u *productRepo) ReleaseReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error) {
+	return u.settle(ctx, req.ReservationId, pb.ReservationStatus_RESERVATION_RELEASED)
+}
+
+// ReleaseExpiredReservations works in batches, skipping reservations other
+// transactions hold, so several service instances can sweep side by side
+func (u *productRepo) ReleaseExpiredReservations(ctx context.Context, now time.Time) (int64, error) {
+	var released int64
+	for {
+		var batch int64
+		err := u.db.WithTx(ctx, func(tx *sql.Tx) error {
+			rows, err := u.db.Builder.Select(reservationColumns).
+				From("stock_reservations").
+				Where(squirrel.And{
+					squirrel.Eq{"status": pb.ReservationStatus_RESERVATION_ACTIVE},
+					squirrel.Lt{"expires_at": now.UTC()},
+				}).
+				// products are locked in id order, like placeOrder does
+				OrderBy("product_id", "id").
+				Limit(sweepBatchSize).
+				Suffix("FOR UPDATE SKIP LOCKED").
+				RunWith(tx).QueryContext(ctx)
+			if err != nil {
+				return err
+			}
+
+			var expired []*pb.Reservation
+			for rows.Next() {
+				reservation, err := scanReservation(rows)
+				if err != nil {
+					rows.Close()
+					return err
+				}
+				expired = append(expired, reservation)
+			}
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return err
+			}
+
+			for _, reservation := range expired {
+				if err := u.unreserve(ctx, tx, reservation, pb.ReservationStatus_RESERVATION_EXPIRED); err != nil {
+					return err
+				}
+			}
+			batch = int64(len(expired))
+			return nil
+		})
+		if err != nil {
+			return released, err
+		}
+
+		released += batch
+		if batch < sweepBatchSize {
+			return released, nil
+		}
+	}
+}
+
+// settle ends an active reservation; committing takes its quantity from stock
+func (u *productRepo) settle(ctx context.Context, id int32, status pb.ReservationStatus) (*pb.Reservation, error) {
+	var reservation *pb.Reservation
+	err := u.db.WithTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		reservation, err = scanReservation(u.db.Builder.Select(reservationColumns).
+			From("stock_reservations").
+			Where(squirrel.Eq{"id": id}).
+			Suffix("FOR UPDATE").
+			RunWith(tx).QueryRowContext(ctx))
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.NotFound("reservation", id)
+		}
+		if err != nil {
+			return err
+		}
+
+		expiresAt, err := time.Parse(time.RFC3339Nano, reservation.ExpiresAt)
+		if err != nil {
+			return err
+		}
+		if err := repo.CheckSettle(id, reservation.Status, expiresAt, time.Now().UTC(), status); err != nil {
+			return err
+		}
+
+		return u.unreserve(ctx, tx, reservation, status)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+// unreserve gives the quantity held by reservation back and records its
+// final status; committing takes the quantity from stock as well
+func (u *productRepo) unreserve(ctx context.Context, tx *sql.Tx, reservation *pb.Reservation, status pb.ReservationStatus) error {
+	_, err := u.db.Builder.Update("stock_reservations").
+		Set("status", status).
+		Where(squirrel.Eq{"id": reservation.Id}).
+		RunWith(tx).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+	reservation.Status = status
+
+	query := u.db.Builder.Update("products").
+		Set("reserved", squirrel.Expr("reserved - ?", reservation.Quantity)).
+		Where(squirrel.Eq{"id": reservation.ProductId})
+	if status != pb.ReservationStatus_RESERVATION_COMMITTED {
+		_, err = query.RunWith(tx).ExecContext(ctx)
+		return err
+	}
+
+	// the amount may have been set below what is reserved since
+	var balance int32
+	err = query.
+		Set("amount", squirrel.Expr("amount - ?", reservation.Quantity)).
+		Set("updated_at", time.Now()).
+		Set("version", nextVersion).
+		Where(squirrel.GtOrEq{"amount": reservation.Quantity}).
+		Suffix("RETURNING amount").
+		RunWith(tx).QueryRowContext(ctx).Scan(&balance)
+	if errors.Is(err, sql.ErrNoRows) {
+		var amount int32
+		err := u.db.Builder.Select("amount").From("products").Where(squirrel.Eq{"id": reservation.ProductId}).
+			RunWith(tx).QueryRowContext(ctx).Scan(&amount)
+		if err != nil {
+			return err
+		}
+		return errs.InsufficientStock(reservation.ProductId, amount, reservation.Quantity)
+	}
+	if err != nil {
+		return err
+	}
+
+	return u.recordMovement(ctx, tx, repo.NewMovement(ctx, reservation.ProductId, -reservation.Quantity, balance,
+		pb.StockMovementReason_MOVEMENT_RESERVATION, repo.ReservationRef(reservation.Id)))
+}
+
+func scanReservation(row squirrel.RowScanner) (*pb.Reservation, error) {
+	var reservation pb.Reservation
+	err := row.Scan(
+		&reservation.Id,
+		&reservation.ProductId,
+		&reservation.Quantity,
+		&reservation.Status,
+		&reservation.ExpiresAt,
+		&reservation.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservation, nil
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/storage/repo"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// tsQuery parses the query text with the websearch syntax; the search column
+// is built with the same 'english' configuration
+const tsQuery = `websearch_to_tsquery('english', ?)`
+
+// SearchProducts ranks products on the weighted search tsvector. Short
+// queries also match names by trigram word similarity, which tolerates typos.
+func (u *productRepo) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	page, err := repo.NewSearchPage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	text := page.Query.Text
+	match := squirrel.Expr("search @@ "+tsQuery, text)
+	rank := squirrel.Expr("ts_rank(search, "+tsQuery+")", text)
+	if page.Query.Fuzzy {
+		match = squirrel.Expr("(search @@ "+tsQuery+" OR ? <% name)", text, text)
+		rank = squirrel.Expr("ts_rank(search, "+tsQuery+") + word_similarity(?, name)", text, text)
+	}
+
+	where := squirrel.And{notDeleted, match}
+	if req.CategoryId != 0 {
+		where = append(where, squirrel.Expr(inCategoryTree, req.CategoryId))
+	}
+
+	var total int64
+	err = u.db.Builder.Select("COUNT(*)").From("products").Where(where).
+		RunWith(u.db.DB).QueryRowContext(ctx).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.SearchProductsResponse{}
+	if page.Facets != nil {
+		if response.Facets, err = u.facets(ctx, page.Facets, where); err != nil {
+			return nil, err
+		}
+	}
+
+	// one extra row tells whether there is a next page
+	query := u.db.Builder.Select(productColumns).
+		Column(squirrel.Alias(rank, "score")).
+		From("products").
+		Where(where).
+		OrderBy("score DESC", "id").
+		Offset(uint64(page.Offset)).
+		Limit(uint64(page.Limit) + 1)
+
+	rows, err := query.RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hits []*pb.SearchHit
+	for rows.Next() {
+		var score float32
+		product, err := scanProduct(rows, &score)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, &pb.SearchHit{Product: product, Score: score})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	page.Fill(response, hits, total)
+
+	return response, nil
+}
+
+// SuggestProducts matches the start of lower(name) under the "C" collation,
+// where the products_name_prefix index serves both the LIKE and the order
+func (u *productRepo) SuggestProducts(ctx context.Context, req *pb.SuggestProductsRequest) (*pb.SuggestProductsResponse, error) {
+	where := squirrel.And{
+		notDeleted,
+		squirrel.Expr(`lower(name) COLLATE "C" LIKE lower(?)`, likeEscaper.Replace(req.Prefix)+"%"),
+	}
+	if req.InStock {
+		where = append(where, squirrel.Expr(available+" > 0"))
+	}
+
+	rows, err := u.db.Builder.Select("id", "name").
+		From("products").
+		Where(where).
+		OrderBy(`lower(name) COLLATE "C"`, "id").
+		Limit(uint64(req.Limit)).
+		RunWith(u.db.DB).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := &pb.SuggestProductsResponse{}
+	for rows.Next() {
+		var suggestion pb.Suggestion
+		if err := rows.Scan(&suggestion.ProductId, &suggestion.Name); err != nil {
+			return nil, err
+		}
+		response.Suggestions = append(response.Suggestions, &suggestion)
+	}
+
+	return response, rows.Err()
+}
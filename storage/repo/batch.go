@@ -0,0 +1,24 @@
+package repo
+
+import (
+	pb "exam/product-service/genproto/product-service"
+)
+
+// InRequestOrder arranges the products found by a batch lookup in the
+// order of ids and lists the ids that were not found
+func InRequestOrder(ids []int32, found map[int32]*pb.Product) *pb.GetProductsByIdsResponse {
+	response := &pb.GetProductsByIdsResponse{}
+	reported := make(map[int32]bool)
+	for _, id := range ids {
+		if product, ok := found[id]; ok {
+			response.Products = append(response.Products, product)
+			continue
+		}
+		if !reported[id] {
+			reported[id] = true
+			response.MissingIds = append(response.MissingIds, id)
+		}
+	}
+
+	return response
+}
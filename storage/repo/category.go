@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+)
+
+// CategoryService interface
+type CategoryServiceI interface {
+	CreateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error)
+	GetCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Category, error)
+	UpdateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error)
+	DeleteCategory(ctx context.Context, req *pb.GetCategoryId) (*pb.Status, error)
+	ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error)
+	SetProductCategories(ctx context.Context, req *pb.ProductCategoriesRequest) (*pb.ListCategoriesResponse, error)
+	GetProductCategories(ctx context.Context, req *pb.GetProductId) (*pb.ListCategoriesResponse, error)
+}
@@ -0,0 +1,122 @@
+package repo
+
+import (
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"sort"
+)
+
+// DefaultPriceBounds split prices into buckets when a request names none
+var DefaultPriceBounds = []float32{10, 25, 50, 100, 250, 500, 1000}
+
+// FacetSpec describes the facets a request asked for
+type FacetSpec struct {
+	// Bounds are the lower bounds of the price buckets, starting at 0
+	Bounds []float32
+}
+
+// NewFacetSpec returns nil unless include is set. upperBounds must be
+// positive and ascending.
+func NewFacetSpec(include bool, upperBounds []float32) (*FacetSpec, error) {
+	if !include {
+		return nil, nil
+	}
+
+	if len(upperBounds) == 0 {
+		upperBounds = DefaultPriceBounds
+	}
+	for i, bound := range upperBounds {
+		if bound <= 0 || (i > 0 && bound <= upperBounds[i-1]) {
+			return nil, errs.InvalidArgument("price_bounds: must be positive and ascending")
+		}
+	}
+
+	return &FacetSpec{Bounds: append([]float32{0}, upperBounds...)}, nil
+}
+
+// Bucket returns the index of the price bucket holding price
+func (s *FacetSpec) Bucket(price float32) int {
+	return sort.Search(len(s.Bounds), func(i int) bool { return s.Bounds[i] > price }) - 1
+}
+
+// Counter starts counting facets for s
+func (s *FacetSpec) Counter() *FacetCounter {
+	return &FacetCounter{
+		spec:       s,
+		categories: make(map[int32]*pb.CategoryFacet),
+		buckets:    make([]int64, len(s.Bounds)),
+	}
+}
+
+// FacetCounter accumulates facet counts, either row by row for backends
+// that count in Go or from the groups a database returns
+type FacetCounter struct {
+	spec       *FacetSpec
+	categories map[int32]*pb.CategoryFacet
+	buckets    []int64
+	inStock    int64
+	outOfStock int64
+}
+
+// Add counts one product assigned to categories that has available stock
+// not held by reservations
+func (c *FacetCounter) Add(product *pb.Product, available int32, categories []*pb.Category) {
+	for _, category := range categories {
+		c.AddCategory(category.Id, category.Name, 1)
+	}
+	c.AddBucket(c.spec.Bucket(product.Price), 1)
+	c.AddStock(available > 0, 1)
+}
+
+func (c *FacetCounter) AddCategory(id int32, name string, count int64) {
+	facet, ok := c.categories[id]
+	if !ok {
+		facet = &pb.CategoryFacet{CategoryId: id, Name: name}
+		c.categories[id] = facet
+	}
+	facet.Count += count
+}
+
+// AddBucket counts products in the bucket with index i; prices below 0
+// land in the first bucket
+func (c *FacetCounter) AddBucket(i int, count int64) {
+	if i < 0 {
+		i = 0
+	}
+	c.buckets[i] += count
+}
+
+func (c *FacetCounter) AddStock(inStock bool, count int64) {
+	if inStock {
+		c.inStock += count
+	} else {
+		c.outOfStock += count
+	}
+}
+
+// Facets renders the counts, most popular categories first
+func (c *FacetCounter) Facets() *pb.Facets {
+	facets := &pb.Facets{InStock: c.inStock, OutOfStock: c.outOfStock}
+
+	for _, facet := range c.categories {
+		facets.Categories = append(facets.Categories, facet)
+	}
+	sort.Slice(facets.Categories, func(i, j int) bool {
+		a, b := facets.Categories[i], facets.Categories[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.CategoryId < b.CategoryId
+	})
+
+	bounds := c.spec.Bounds
+	for i, count := range c.buckets {
+		bucket := &pb.PriceBucket{Min: bounds[i], Count: count}
+		if i+1 < len(bounds) {
+			bucket.Max = bounds[i+1]
+		}
+		facets.PriceBuckets = append(facets.PriceBuckets, bucket)
+	}
+
+	return facets
+}
@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/actor"
+	"exam/product-service/pkg/errs"
+	"fmt"
+	"time"
+)
+
+// LedgerService interface
+type LedgerServiceI interface {
+	ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error)
+	ReconcileStock(ctx context.Context, req *pb.ReconcileStockRequest) (*pb.ReconcileStockResponse, error)
+}
+
+// MovementFilter is the backend-neutral form of a ListStockMovementsRequest.
+// Zero times leave a side of the range open.
+type MovementFilter struct {
+	ProductId int32
+	From      time.Time
+	To        time.Time
+	Offset    int64
+	Limit     int32
+}
+
+// NewMovementFilter parses the time range of req
+func NewMovementFilter(req *pb.ListStockMovementsRequest) (*MovementFilter, error) {
+	filter := &MovementFilter{
+		ProductId: req.ProductId,
+		Offset:    int64(req.Page-1) * int64(req.Limit),
+		Limit:     req.Limit,
+	}
+
+	var err error
+	if filter.From, err = parseBound(req.From); err != nil {
+		return nil, errs.InvalidArgument("from: %v", err)
+	}
+	if filter.To, err = parseBound(req.To); err != nil {
+		return nil, errs.InvalidArgument("to: %v", err)
+	}
+
+	return filter, nil
+}
+
+// Contains reports whether a movement made at createdAt falls in the range
+func (f *MovementFilter) Contains(createdAt time.Time) bool {
+	return (f.From.IsZero() || !createdAt.Before(f.From)) && (f.To.IsZero() || createdAt.Before(f.To))
+}
+
+// NewMovement describes a change of delta to a product that left balance in
+// stock, made by the actor of ctx
+func NewMovement(ctx context.Context, productId, delta, balance int32, reason pb.StockMovementReason, reference string) *pb.StockMovement {
+	return &pb.StockMovement{
+		ProductId: productId,
+		Delta:     delta,
+		Reason:    reason,
+		Actor:     actor.From(ctx),
+		Reference: reference,
+		Balance:   balance,
+	}
+}
+
+// OrderRef references an order from a ledger entry
+func OrderRef(orderId int32) string {
+	return fmt.Sprintf("order/%d", orderId)
+}
+
+// ReservationRef references a stock reservation from a ledger entry
+func ReservationRef(reservationId int32) string {
+	return fmt.Sprintf("reservation/%d", reservationId)
+}
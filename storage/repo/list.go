@@ -0,0 +1,189 @@
+package repo
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"strconv"
+	"time"
+)
+
+// sortColumns maps each sort option to the product column or field it orders by
+var sortColumns = map[pb.SortField]string{
+	pb.SortField_SORT_ID:         "id",
+	pb.SortField_SORT_PRICE:      "price",
+	pb.SortField_SORT_NAME:       "name",
+	pb.SortField_SORT_CREATED_AT: "created_at",
+	pb.SortField_SORT_AMOUNT:     "amount",
+}
+
+// ListFilter is the backend-neutral form of the filters and sort order of
+// a GetListRequest. Zero values leave a filter open.
+type ListFilter struct {
+	Name          string
+	MinPrice      float32
+	MaxPrice      float32
+	InStock       bool
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	SortBy        pb.SortField
+	Descending    bool
+
+	// Offset and Limit select the page; Offset is always 0 when After is set
+	Offset int64
+	Limit  int32
+	// After continues a keyset scan from a page token
+	After *Cursor
+	// Facets is nil unless the request asked for them
+	Facets *FacetSpec
+}
+
+// Cursor is the last product of the previous page: its sort key, typed as
+// float32, string, time.Time or int32 depending on the sort field, and its id
+type Cursor struct {
+	Key interface{}
+	Id  int32
+}
+
+// pageToken is the decoded form of next_page_token
+type pageToken struct {
+	SortBy     pb.SortField `json:"s"`
+	Descending bool         `json:"d,omitempty"`
+	Key        string       `json:"k"`
+	Id         int32        `json:"i"`
+}
+
+// NewListFilter parses the filters of req
+func NewListFilter(req *pb.GetListRequest) (*ListFilter, error) {
+	if _, ok := sortColumns[req.SortBy]; !ok {
+		return nil, errs.InvalidArgument("sort_by: unknown sort field %d", req.SortBy)
+	}
+
+	filter := &ListFilter{
+		Name:       req.Name,
+		MinPrice:   req.MinPrice,
+		MaxPrice:   req.MaxPrice,
+		InStock:    req.InStock,
+		SortBy:     req.SortBy,
+		Descending: req.Descending,
+		Offset:     int64(req.Page-1) * int64(req.Limit),
+		Limit:      req.Limit,
+	}
+
+	var err error
+	if filter.CreatedAfter, err = parseBound(req.CreatedAfter); err != nil {
+		return nil, errs.InvalidArgument("created_after: %v", err)
+	}
+	if filter.CreatedBefore, err = parseBound(req.CreatedBefore); err != nil {
+		return nil, errs.InvalidArgument("created_before: %v", err)
+	}
+
+	if filter.Facets, err = NewFacetSpec(req.IncludeFacets, req.PriceBounds); err != nil {
+		return nil, err
+	}
+
+	if req.PageToken != "" {
+		if filter.After, err = decodeToken(req.PageToken, req.SortBy, req.Descending); err != nil {
+			return nil, errs.InvalidArgument("page_token: %v", err)
+		}
+		filter.Offset = 0
+	}
+
+	return filter, nil
+}
+
+// SortColumn is the product column or field the list is ordered by
+func (f *ListFilter) SortColumn() string {
+	return sortColumns[f.SortBy]
+}
+
+func parseBound(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// created_at columns hold UTC wall clock time
+	return t.UTC(), nil
+}
+
+// Fill sets the page of response from products, which the backends fetch
+// with Limit+1 rows so the extra one reveals whether a next page exists,
+// and from total, the number of products matching the filters
+func (f *ListFilter) Fill(response *pb.GetListResponse, products []*pb.Product, total int64) {
+	response.HasNext = len(products) > int(f.Limit)
+	if response.HasNext {
+		products = products[:f.Limit]
+		response.NextPageToken = f.token(products[len(products)-1])
+	}
+
+	response.Products = products
+	response.Count = int64(len(products))
+	response.TotalCount = total
+	if f.Limit > 0 {
+		response.TotalPages = int32((total + int64(f.Limit) - 1) / int64(f.Limit))
+	}
+}
+
+func (f *ListFilter) token(last *pb.Product) string {
+	var key string
+	switch f.SortBy {
+	case pb.SortField_SORT_PRICE:
+		key = strconv.FormatFloat(float64(last.Price), 'g', -1, 32)
+	case pb.SortField_SORT_NAME:
+		key = last.Name
+	case pb.SortField_SORT_CREATED_AT:
+		key = last.CreatedAt
+	case pb.SortField_SORT_AMOUNT:
+		key = strconv.Itoa(int(last.Amount))
+	}
+
+	data, _ := json.Marshal(pageToken{SortBy: f.SortBy, Descending: f.Descending, Key: key, Id: last.Id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeToken(value string, sortBy pb.SortField, descending bool) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("malformed token")
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, errors.New("malformed token")
+	}
+	if token.SortBy != sortBy || token.Descending != descending {
+		return nil, errors.New("token was issued for a different sort order")
+	}
+
+	cursor := &Cursor{Id: token.Id}
+	switch token.SortBy {
+	case pb.SortField_SORT_PRICE:
+		var price float64
+		price, err = strconv.ParseFloat(token.Key, 32)
+		cursor.Key = float32(price)
+	case pb.SortField_SORT_NAME:
+		cursor.Key = token.Key
+	case pb.SortField_SORT_CREATED_AT:
+		var createdAt time.Time
+		createdAt, err = time.Parse(time.RFC3339Nano, token.Key)
+		cursor.Key = createdAt.UTC()
+	case pb.SortField_SORT_AMOUNT:
+		var amount int64
+		amount, err = strconv.ParseInt(token.Key, 10, 32)
+		cursor.Key = int32(amount)
+	default:
+		cursor.Key = token.Id
+	}
+	if err != nil {
+		return nil, errors.New("malformed token")
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,58 @@
+package repo
+
+import (
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+)
+
+// UpdatableProductFields are the field mask paths UpdateProduct accepts
+var UpdatableProductFields = []string{"name", "description", "price", "amount"}
+
+// UpdateMaskPaths returns the product fields an UpdateProductRequest writes.
+// An empty or missing mask means every updatable field.
+func UpdateMaskPaths(req *pb.UpdateProductRequest) ([]string, error) {
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return UpdatableProductFields, nil
+	}
+
+	paths := make([]string, 0, len(req.UpdateMask.Paths))
+	seen := make(map[string]bool, len(req.UpdateMask.Paths))
+	for _, path := range req.UpdateMask.Paths {
+		if !IsUpdatableProductField(path) {
+			return nil, errs.InvalidArgument("update_mask: unknown product field %q", path)
+		}
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+
+	return paths, nil
+}
+
+// IsUpdatableProductField reports whether path may appear in an update mask
+func IsUpdatableProductField(path string) bool {
+	for _, field := range UpdatableProductFields {
+		if field == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ProductFieldValue returns the value of a masked field of product
+func ProductFieldValue(product *pb.Product, path string) interface{} {
+	switch path {
+	case "name":
+		return product.Name
+	case "description":
+		return product.Description
+	case "price":
+		return product.Price
+	case "amount":
+		return product.Amount
+	default:
+		return nil
+	}
+}
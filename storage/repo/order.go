@@ -0,0 +1,157 @@
+package repo
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// OrderService interface
+type OrderServiceI interface {
+	CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.Order, error)
+	GetOrder(ctx context.Context, req *pb.GetOrderId) (*pb.Order, error)
+	ListOrdersByUser(ctx context.Context, req *pb.ListOrdersByUserRequest) (*pb.ListOrdersResponse, error)
+	TransitionOrder(ctx context.Context, req *pb.TransitionOrderRequest) (*pb.Order, error)
+	ReturnOrderItem(ctx context.Context, req *pb.ReturnOrderItemRequest) (*pb.OrderReturn, error)
+}
+
+// orderTransitions lists the statuses an order may move to from each status;
+// cancelled and refunded orders are final
+var orderTransitions = map[pb.OrderStatus][]pb.OrderStatus{
+	pb.OrderStatus_ORDER_PENDING:   {pb.OrderStatus_ORDER_PAID, pb.OrderStatus_ORDER_CANCELLED},
+	pb.OrderStatus_ORDER_PAID:      {pb.OrderStatus_ORDER_SHIPPED, pb.OrderStatus_ORDER_CANCELLED},
+	pb.OrderStatus_ORDER_SHIPPED:   {pb.OrderStatus_ORDER_DELIVERED},
+	pb.OrderStatus_ORDER_DELIVERED: {pb.OrderStatus_ORDER_REFUNDED},
+}
+
+// statusColumns maps every status but pending to the column or field
+// holding the time an order entered it
+var statusColumns = map[pb.OrderStatus]string{
+	pb.OrderStatus_ORDER_PAID:      "paid_at",
+	pb.OrderStatus_ORDER_SHIPPED:   "shipped_at",
+	pb.OrderStatus_ORDER_DELIVERED: "delivered_at",
+	pb.OrderStatus_ORDER_CANCELLED: "cancelled_at",
+	pb.OrderStatus_ORDER_REFUNDED:  "refunded_at",
+}
+
+// CheckTransition fails with FailedPrecondition unless order orderId may
+// move from status from to status to
+func CheckTransition(orderId int32, from, to pb.OrderStatus) error {
+	for _, next := range orderTransitions[from] {
+		if next == to {
+			return nil
+		}
+	}
+
+	return errs.FailedPrecondition("ILLEGAL_TRANSITION", "order %d cannot move from %s to %s", orderId, from, to)
+}
+
+// StatusColumn is the column or field holding the time an order entered status
+func StatusColumn(status pb.OrderStatus) string {
+	return statusColumns[status]
+}
+
+// SetStatusTime records on order that it entered status at
+func SetStatusTime(order *pb.Order, status pb.OrderStatus, at string) {
+	switch status {
+	case pb.OrderStatus_ORDER_PAID:
+		order.PaidAt = at
+	case pb.OrderStatus_ORDER_SHIPPED:
+		order.ShippedAt = at
+	case pb.OrderStatus_ORDER_DELIVERED:
+		order.DeliveredAt = at
+	case pb.OrderStatus_ORDER_CANCELLED:
+		order.CancelledAt = at
+	case pb.OrderStatus_ORDER_REFUNDED:
+		order.RefundedAt = at
+	}
+}
+
+// MergeOrderLines adds up the quantities of lines for the same product and
+// sorts the result by product id, the order in which backends take stock
+// so that concurrent orders never wait on each other in a cycle
+func MergeOrderLines(lines []*pb.OrderLine) ([]*pb.OrderLine, error) {
+	if len(lines) == 0 {
+		return nil, errs.InvalidArgument("an order needs at least one item")
+	}
+
+	quantities := make(map[int32]int64, len(lines))
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return nil, errs.InvalidArgument("quantity of product %d must be positive", line.ProductId)
+		}
+		quantities[line.ProductId] += int64(line.Quantity)
+		if quantities[line.ProductId] > math.MaxInt32 {
+			return nil, errs.InvalidArgument("quantity of product %d is too large", line.ProductId)
+		}
+	}
+
+	merged := make([]*pb.OrderLine, 0, len(quantities))
+	for id, quantity := range quantities {
+		merged = append(merged, &pb.OrderLine{ProductId: id, Quantity: int32(quantity)})
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].ProductId < merged[j].ProductId })
+
+	return merged, nil
+}
+
+// SetOrderTotals derives the totals of order from its items
+func SetOrderTotals(order *pb.Order) {
+	var price float64
+	order.TotalQuantity = 0
+	for _, item := range order.Items {
+		price += float64(item.UnitPrice) * float64(item.Quantity)
+		order.TotalQuantity += item.Quantity
+	}
+	order.TotalPrice = float32(price)
+}
+
+// returnableStatuses are the statuses of orders that take returns
+var returnableStatuses = map[pb.OrderStatus]bool{
+	pb.OrderStatus_ORDER_PAID:      true,
+	pb.OrderStatus_ORDER_SHIPPED:   true,
+	pb.OrderStatus_ORDER_DELIVERED: true,
+}
+
+// CheckReturnable fails with FailedPrecondition unless an order in status
+// takes returns
+func CheckReturnable(orderId int32, status pb.OrderStatus) error {
+	if !returnableStatuses[status] {
+		return errs.FailedPrecondition("ORDER_NOT_RETURNABLE", "order %d is %s and takes no returns", orderId, status)
+	}
+
+	return nil
+}
+
+// CheckReturnQuantity fails with FailedPrecondition when requested exceeds
+// what is left to return of line
+func CheckReturnQuantity(line *pb.Purchase, requested int32) error {
+	left := line.Quantity - line.ReturnedQuantity
+	if requested > left {
+		return errs.FailedPrecondition("RETURN_EXCEEDS_PURCHASE",
+			"order %d has %d of product %d left to return, %d requested", line.OrderId, left, line.ProductId, requested)
+	}
+
+	return nil
+}
+
+// OrderItemNotFound reports a product that is not part of an order
+func OrderItemNotFound(orderId, productId int32) error {
+	return errs.NotFound("order_item", fmt.Sprintf("%d/%d", orderId, productId))
+}
+
+// NewOrderReturn describes the return of req against line; the refund is
+// priced at what the customer paid
+func NewOrderReturn(req *pb.ReturnOrderItemRequest, line *pb.Purchase) *pb.OrderReturn {
+	return &pb.OrderReturn{
+		OrderId:      req.OrderId,
+		ProductId:    req.ProductId,
+		Quantity:     req.Quantity,
+		Reason:       req.Reason,
+		Restocked:    req.Restock,
+		RefundAmount: float32(float64(line.UnitPrice) * float64(req.Quantity)),
+	}
+}
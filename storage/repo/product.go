@@ -7,9 +7,16 @@ import (
 
 // ProductService interface
 type ProductServiceI interface {
+	CategoryServiceI
+	SearchServiceI
+	OrderServiceI
+	ReservationServiceI
+	LedgerServiceI
+
 	CreateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error)
 	GetProductById(ctx context.Context, req *pb.GetProductId) (*pb.Product, error)
-	UpdateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error)
+	GetProductsByIds(ctx context.Context, req *pb.GetProductsByIdsRequest) (*pb.GetProductsByIdsResponse, error)
+	UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error)
 	DeleteProduct(ctx context.Context, req *pb.GetProductId) (*pb.Status, error)
 	ListProducts(ctx context.Context, req *pb.GetListRequest) (*pb.GetListResponse, error)
 	IncreaseProductAmount(ctx context.Context, req *pb.ProductAmountRequest) (*pb.ProductAmountResponse, error)
@@ -17,4 +24,6 @@ type ProductServiceI interface {
 	CheckAmount(ctx context.Context, req *pb.GetProductId) (*pb.CheckAmountResponse, error)
 	BuyProduct(ctx context.Context, req *pb.BuyProductRequest) (*pb.Product, error)
 	GetPurchasedProductsByUserId(ctx context.Context, req *pb.GetUserID) (*pb.GetPurchasedProductsResponse, error)
-}
\ No newline at end of file
+	RestoreProduct(ctx context.Context, req *pb.GetProductId) (*pb.Product, error)
+	PurgeDeletedProducts(ctx context.Context, req *pb.PurgeDeletedProductsRequest) (*pb.PurgeDeletedProductsResponse, error)
+}
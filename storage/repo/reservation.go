@@ -0,0 +1,55 @@
+package repo
+
+import (
+	"context"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"time"
+)
+
+const (
+	// DefaultReservationTTL applies when a ReserveStockRequest names no TTL
+	DefaultReservationTTL = 15 * time.Minute
+	// MaxReservationTTL caps ReserveStockRequest.ttl_seconds
+	MaxReservationTTL = 24 * time.Hour
+)
+
+// ReservationService interface
+type ReservationServiceI interface {
+	ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.Reservation, error)
+	CommitReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error)
+	ReleaseReservation(ctx context.Context, req *pb.GetReservationId) (*pb.Reservation, error)
+	// ReleaseExpiredReservations marks the active reservations that expired
+	// before now as expired, returns their stock and reports how many there were
+	ReleaseExpiredReservations(ctx context.Context, now time.Time) (int64, error)
+}
+
+// ReservationExpiry is when a reservation made at now for req lapses
+func ReservationExpiry(req *pb.ReserveStockRequest, now time.Time) time.Time {
+	ttl := DefaultReservationTTL
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+
+	return now.Add(ttl)
+}
+
+// CheckSettle fails with FailedPrecondition unless an active reservation
+// may move to status to at now; reservations past their expiry can still be
+// released but no longer committed
+func CheckSettle(id int32, status pb.ReservationStatus, expiresAt, now time.Time, to pb.ReservationStatus) error {
+	if status != pb.ReservationStatus_RESERVATION_ACTIVE {
+		return errs.FailedPrecondition("RESERVATION_NOT_ACTIVE", "reservation %d is already %s", id, status)
+	}
+	if to == pb.ReservationStatus_RESERVATION_COMMITTED && !now.Before(expiresAt) {
+		return errs.FailedPrecondition("RESERVATION_EXPIRED", "reservation %d expired at %s", id, expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// Available is the stock of a product not held by reservations. It is never
+// negative, even when an update lowered the amount below what is reserved.
+func Available(amount, reserved int32) int32 {
+	return max(amount-reserved, 0)
+}
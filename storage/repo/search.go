@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/pkg/search"
+	"sort"
+)
+
+// SearchService interface
+type SearchServiceI interface {
+	SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error)
+	SuggestProducts(ctx context.Context, req *pb.SuggestProductsRequest) (*pb.SuggestProductsResponse, error)
+}
+
+// SearchPage is the backend-neutral form of a SearchProductsRequest
+type SearchPage struct {
+	Query  search.Query
+	Offset int64
+	Limit  int32
+	// Facets is nil unless the request asked for them
+	Facets *FacetSpec
+}
+
+// searchToken is the decoded form of a search next_page_token. Relevance
+// scores are not stable enough to seek on, so search tokens carry an offset.
+type searchToken struct {
+	Query  string `json:"q"`
+	Offset int64  `json:"o"`
+}
+
+// NewSearchPage parses the query and paging of req
+func NewSearchPage(req *pb.SearchProductsRequest) (*SearchPage, error) {
+	page := &SearchPage{
+		Query:  search.Parse(req.Query),
+		Offset: int64(req.Page-1) * int64(req.Limit),
+		Limit:  req.Limit,
+	}
+	if len(page.Query.Terms) == 0 {
+		return nil, errs.InvalidArgument("query: no words to search for")
+	}
+
+	var err error
+	if page.Facets, err = NewFacetSpec(req.IncludeFacets, req.PriceBounds); err != nil {
+		return nil, err
+	}
+
+	if req.PageToken != "" {
+		var token searchToken
+		data, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+		if err == nil {
+			err = json.Unmarshal(data, &token)
+		}
+		if err != nil || token.Offset < 0 {
+			return nil, errs.InvalidArgument("page_token: malformed token")
+		}
+		if token.Query != page.Query.Text {
+			return nil, errs.InvalidArgument("page_token: token was issued for a different query")
+		}
+		page.Offset = token.Offset
+	}
+
+	return page, nil
+}
+
+// Fill sets the page of response from hits, fetched with Limit+1 rows like
+// ListFilter.Fill, and highlights the matched words of every hit
+func (p *SearchPage) Fill(response *pb.SearchProductsResponse, hits []*pb.SearchHit, total int64) {
+	response.HasNext = len(hits) > int(p.Limit)
+	if response.HasNext {
+		hits = hits[:p.Limit]
+
+		data, _ := json.Marshal(searchToken{Query: p.Query.Text, Offset: p.Offset + int64(p.Limit)})
+		response.NextPageToken = base64.RawURLEncoding.EncodeToString(data)
+	}
+
+	for _, hit := range hits {
+		hit.NameHighlight = p.Query.Highlight(hit.Product.Name)
+		hit.DescriptionHighlight = p.Query.Highlight(hit.Product.Description)
+	}
+
+	response.Hits = hits
+	response.Count = int64(len(hits))
+	response.TotalCount = total
+	if p.Limit > 0 {
+		response.TotalPages = int32((total + int64(p.Limit) - 1) / int64(p.Limit))
+	}
+}
+
+// SortHits orders hits scored outside a database by score, best first, then by id
+func SortHits(hits []*pb.SearchHit) {
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Product.Id < hits[j].Product.Id
+	})
+}
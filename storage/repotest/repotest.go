@@ -0,0 +1,1332 @@
+// Package repotest is a backend-agnostic conformance suite for
+// repo.ProductServiceI. Every storage backend runs it to prove it has the
+// same observable semantics as the others.
+package repotest
+
+import (
+	"context"
+	"errors"
+	pb "exam/product-service/genproto/product-service"
+	"exam/product-service/pkg/actor"
+	"exam/product-service/pkg/errs"
+	"exam/product-service/storage/repo"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// missingProductId is never assigned by any backend
+const missingProductId = int32(-1)
+
+// Factory builds the repository under test and returns a cleanup func.
+// It is called once per test, so backends may hand out a fresh store or
+// share one database between tests.
+type Factory func(t *testing.T) (repo.ProductServiceI, func())
+
+type Suite struct {
+	suite.Suite
+	Factory    Factory
+	Repository repo.ProductServiceI
+	cleanup    func()
+}
+
+// Run executes the conformance suite against the backend built by factory
+func Run(t *testing.T, factory Factory) {
+	suite.Run(t, &Suite{Factory: factory})
+}
+
+func (s *Suite) SetupTest() {
+	s.Repository, s.cleanup = s.Factory(s.T())
+}
+
+func (s *Suite) TearDownTest() {
+	if s.cleanup != nil {
+		s.cleanup()
+	}
+}
+
+func (s *Suite) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), time.Second*time.Duration(7))
+}
+
+func (s *Suite) createProduct(ctx context.Context, amount int32) *pb.Product {
+	product, err := s.Repository.CreateProduct(ctx, &pb.Product{
+		Name:        gofakeit.FirstName(),
+		Description: gofakeit.ProductDescription(),
+		Price:       float32(gofakeit.Price(10.1, 19.2)),
+		Amount:      amount,
+	})
+	s.Suite.Require().NoError(err)
+	s.Suite.Require().NotNil(product)
+
+	return product
+}
+
+func (s *Suite) TestCRUD() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	//Create product
+	created := s.createProduct(ctx, 7)
+	s.Suite.NotZero(created.Id)
+	s.Suite.NotEmpty(created.CreatedAt)
+
+	//Get product
+	got, err := s.Repository.GetProductById(ctx, &pb.GetProductId{ProductId: created.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(created.Id, got.Id)
+	s.Suite.Equal(created.Name, got.Name)
+	s.Suite.Equal(created.Description, got.Description)
+	s.Suite.Equal(created.Price, got.Price)
+	s.Suite.Equal(created.Amount, got.Amount)
+
+	//Update product
+	update := &pb.Product{
+		Id:          created.Id,
+		Name:        gofakeit.FirstName(),
+		Description: gofakeit.ProductDescription(),
+		Price:       float32(gofakeit.Price(20.1, 29.2)),
+		Amount:      3,
+	}
+	updated, err := s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{Product: update})
+	s.Suite.NoError(err)
+	s.Suite.Equal(created.Id, updated.Id)
+	s.Suite.Equal(update.Name, updated.Name)
+	s.Suite.Equal(update.Description, updated.Description)
+	s.Suite.Equal(update.Price, updated.Price)
+	s.Suite.Equal(update.Amount, updated.Amount)
+	s.Suite.NotEmpty(updated.UpdatedAt)
+
+	got, err = s.Repository.GetProductById(ctx, &pb.GetProductId{ProductId: created.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(update.Name, got.Name)
+	s.Suite.Equal(update.Amount, got.Amount)
+
+	//Delete product
+	status, err := s.Repository.DeleteProduct(ctx, &pb.GetProductId{ProductId: created.Id})
+	s.Suite.NoError(err)
+	s.Suite.True(status.Success)
+
+	_, err = s.Repository.GetProductById(ctx, &pb.GetProductId{ProductId: created.Id})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+}
+
+func (s *Suite) TestNotFound() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	productId := &pb.GetProductId{ProductId: missingProductId}
+	amountReq := &pb.ProductAmountRequest{ProductId: missingProductId, AmountBy: 1}
+
+	_, err := s.Repository.GetProductById(ctx, productId)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	_, err = s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product: &pb.Product{Id: missingProductId, Name: gofakeit.FirstName()},
+	})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	_, err = s.Repository.DeleteProduct(ctx, productId)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	_, err = s.Repository.CheckAmount(ctx, productId)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	_, err = s.Repository.IncreaseProductAmount(ctx, amountReq)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	_, err = s.Repository.DecreaseProductAmount(ctx, amountReq)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	_, err = s.Repository.BuyProduct(ctx, &pb.BuyProductRequest{
+		UserId:    uuid.New().String(),
+		ProductId: missingProductId,
+		Amount:    1,
+	})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+}
+
+func (s *Suite) TestGetProductsByIds() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	live := s.createProduct(ctx, 1)
+	deleted := s.createProduct(ctx, 1)
+	_, err := s.Repository.DeleteProduct(ctx, &pb.GetProductId{ProductId: deleted.Id})
+	s.Suite.Require().NoError(err)
+
+	ids := []int32{deleted.Id, live.Id, missingProductId, live.Id, missingProductId}
+
+	found, err := s.Repository.GetProductsByIds(ctx, &pb.GetProductsByIdsRequest{ProductIds: ids})
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{live.Id, live.Id}, productIds(found.Products))
+	s.Suite.Equal([]int32{deleted.Id, missingProductId}, found.MissingIds)
+
+	withDeleted, err := s.Repository.GetProductsByIds(ctx, &pb.GetProductsByIdsRequest{ProductIds: ids, IncludeDeleted: true})
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{deleted.Id, live.Id, live.Id}, productIds(withDeleted.Products))
+	s.Suite.Equal([]int32{missingProductId}, withDeleted.MissingIds)
+	s.Suite.Equal(live.Name, withDeleted.Products[1].Name)
+}
+
+func (s *Suite) TestPartialUpdate() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	product := s.createProduct(ctx, 9)
+
+	//Only the price is written
+	updated, err := s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product:    &pb.Product{Id: product.Id, Price: 42.5},
+		UpdateMask: &types.FieldMask{Paths: []string{"price"}},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(product.Id, updated.Id)
+	s.Suite.Equal(float32(42.5), updated.Price)
+	s.Suite.Equal(product.Name, updated.Name)
+	s.Suite.Equal(product.Description, updated.Description)
+	s.Suite.Equal(int32(9), updated.Amount)
+	s.Suite.NotEmpty(updated.CreatedAt)
+
+	got, err := s.Repository.GetProductById(ctx, &pb.GetProductId{ProductId: product.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(float32(42.5), got.Price)
+	s.Suite.Equal(int32(9), got.Amount)
+
+	//Unknown fields are rejected
+	_, err = s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product:    &pb.Product{Id: product.Id},
+		UpdateMask: &types.FieldMask{Paths: []string{"created_at"}},
+	})
+	s.Suite.ErrorIs(err, errs.ErrInvalidArgument)
+}
+
+func (s *Suite) TestPagination() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	const limit = 2
+
+	created := make(map[int32]bool)
+	for i := 0; i < 3; i++ {
+		created[s.createProduct(ctx, 1).Id] = true
+	}
+
+	seen := make(map[int32]bool)
+	for page := int32(1); ; page++ {
+		resp, err := s.Repository.ListProducts(ctx, &pb.GetListRequest{Page: page, Limit: limit})
+		s.Suite.Require().NoError(err)
+		s.Suite.LessOrEqual(len(resp.Products), limit)
+		s.Suite.Equal(int64(len(resp.Products)), resp.Count)
+		s.Suite.Equal(page < resp.TotalPages, resp.HasNext)
+
+		for _, product := range resp.Products {
+			s.Suite.False(seen[product.Id], "product %d listed twice", product.Id)
+			seen[product.Id] = true
+		}
+
+		if len(resp.Products) < limit {
+			break
+		}
+	}
+
+	for id := range created {
+		s.Suite.True(seen[id], "product %d never listed", id)
+	}
+}
+
+func (s *Suite) TestStock() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	product := s.createProduct(ctx, 5)
+
+	//Increase
+	increased, err := s.Repository.IncreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 3})
+	s.Suite.NoError(err)
+	s.Suite.True(increased.IsEnough)
+	s.Suite.Equal(int32(8), increased.Product.Amount)
+	s.Suite.Equal(product.Name, increased.Product.Name)
+
+	//Decrease
+	decreased, err := s.Repository.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 8})
+	s.Suite.NoError(err)
+	s.Suite.True(decreased.IsEnough)
+	s.Suite.Equal(int32(0), decreased.Product.Amount)
+
+	//Decrease below zero
+	rejected, err := s.Repository.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 1})
+	s.Suite.ErrorIs(err, errs.ErrInsufficientStock)
+	s.Suite.NotNil(rejected)
+	s.Suite.False(rejected.IsEnough)
+	s.Suite.Equal(int32(0), rejected.Product.Amount)
+
+	//Check
+	check, err := s.Repository.CheckAmount(ctx, &pb.GetProductId{ProductId: product.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(product.Id, check.ProductId)
+	s.Suite.Equal(int32(0), check.Amount)
+}
+
+func (s *Suite) TestConcurrentDecrease() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	const (
+		stock   = 10
+		buyers  = 50
+		buyEach = 1
+	)
+
+	product := s.createProduct(ctx, stock)
+
+	var (
+		wg        sync.WaitGroup
+		succeeded int32
+		failed    int32
+	)
+	for i := 0; i < buyers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := s.Repository.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{
+				ProductId: product.Id,
+				AmountBy:  buyEach,
+			})
+			switch {
+			case err == nil:
+				s.Suite.True(resp.IsEnough)
+				s.Suite.GreaterOrEqual(resp.Product.Amount, int32(0))
+				atomic.AddInt32(&succeeded, 1)
+			case errors.Is(err, errs.ErrInsufficientStock):
+				s.Suite.False(resp.IsEnough)
+				atomic.AddInt32(&failed, 1)
+			default:
+				s.Suite.NoError(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Suite.Equal(int32(stock/buyEach), succeeded)
+	s.Suite.Equal(int32(buyers-stock/buyEach), failed)
+
+	check, err := s.Repository.CheckAmount(ctx, &pb.GetProductId{ProductId: product.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int32(0), check.Amount)
+}
+
+func (s *Suite) TestBuyProduct() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	product := s.createProduct(ctx, 3)
+	userId := uuid.New().String()
+
+	//Buy product
+	bought, err := s.Repository.BuyProduct(ctx, &pb.BuyProductRequest{
+		UserId:    userId,
+		ProductId: product.Id,
+		Amount:    2,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(product.Id, bought.Id)
+	s.Suite.Equal(int32(1), bought.Amount)
+
+	//Buy more than in stock
+	_, err = s.Repository.BuyProduct(ctx, &pb.BuyProductRequest{
+		UserId:    userId,
+		ProductId: product.Id,
+		Amount:    2,
+	})
+	s.Suite.ErrorIs(err, errs.ErrInsufficientStock)
+
+	//Negative amounts never add stock
+	_, err = s.Repository.BuyProduct(ctx, &pb.BuyProductRequest{
+		UserId:    userId,
+		ProductId: product.Id,
+		Amount:    -10,
+	})
+	s.Suite.ErrorIs(err, errs.ErrInvalidArgument)
+
+	check, err := s.Repository.CheckAmount(ctx, &pb.GetProductId{ProductId: product.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int32(1), check.Amount)
+
+	//Purchase history only holds the successful purchase
+	purchased, err := s.Repository.GetPurchasedProductsByUserId(ctx, &pb.GetUserID{UserId: userId})
+	s.Suite.NoError(err)
+	s.Suite.Len(purchased.Products, 1)
+	s.Suite.Equal(product.Id, purchased.Products[0].Id)
+}
+
+func (s *Suite) TestOrders() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	kettle := s.createProduct(ctx, 5)
+	cup := s.createProduct(ctx, 2)
+	userId := uuid.New().String()
+
+	//Duplicate lines are merged into one item per product
+	order, err := s.Repository.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId: userId,
+		Items: []*pb.OrderLine{
+			{ProductId: cup.Id, Quantity: 1},
+			{ProductId: kettle.Id, Quantity: 2},
+			{ProductId: cup.Id, Quantity: 1},
+		},
+	})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(userId, order.UserId)
+	s.Suite.NotEmpty(order.CreatedAt)
+	s.Suite.Require().Len(order.Items, 2)
+	s.Suite.Equal(int32(4), order.TotalQuantity)
+	s.Suite.InDelta(2*kettle.Price+2*cup.Price, order.TotalPrice, 0.01)
+
+	//Unit prices are captured at purchase time
+	_, err = s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product:    &pb.Product{Id: kettle.Id, Price: kettle.Price + 100},
+		UpdateMask: &types.FieldMask{Paths: []string{"price"}},
+	})
+	s.Suite.Require().NoError(err)
+
+	got, err := s.Repository.GetOrder(ctx, &pb.GetOrderId{OrderId: order.Id})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(order.TotalPrice, got.TotalPrice)
+	for _, item := range got.Items {
+		if item.ProductId == kettle.Id {
+			s.Suite.Equal(kettle.Price, item.UnitPrice)
+		}
+	}
+
+	//Duplicate lines whose sum overflows are rejected
+	_, err = s.Repository.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId: userId,
+		Items: []*pb.OrderLine{
+			{ProductId: kettle.Id, Quantity: math.MaxInt32},
+			{ProductId: kettle.Id, Quantity: math.MaxInt32},
+		},
+	})
+	s.Suite.ErrorIs(err, errs.ErrInvalidArgument)
+
+	//A line without enough stock fails the whole order
+	_, err = s.Repository.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId: userId,
+		Items: []*pb.OrderLine{
+			{ProductId: kettle.Id, Quantity: 1},
+			{ProductId: cup.Id, Quantity: 1},
+		},
+	})
+	s.Suite.ErrorIs(err, errs.ErrInsufficientStock)
+
+	check, err := s.Repository.CheckAmount(ctx, &pb.GetProductId{ProductId: kettle.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int32(3), check.Amount)
+
+	_, err = s.Repository.GetOrder(ctx, &pb.GetOrderId{OrderId: missingProductId})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	//BuyProduct places a single line order
+	_, err = s.Repository.BuyProduct(ctx, &pb.BuyProductRequest{UserId: userId, ProductId: kettle.Id, Amount: 1})
+	s.Suite.Require().NoError(err)
+
+	orders, err := s.Repository.ListOrdersByUser(ctx, &pb.ListOrdersByUserRequest{UserId: userId, Page: 1, Limit: 1})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int64(2), orders.TotalCount)
+	s.Suite.Require().Len(orders.Orders, 1)
+	s.Suite.NotEqual(order.Id, orders.Orders[0].Id)
+	s.Suite.Require().Len(orders.Orders[0].Items, 1)
+	s.Suite.Equal(kettle.Id, orders.Orders[0].Items[0].ProductId)
+
+	orders, err = s.Repository.ListOrdersByUser(ctx, &pb.ListOrdersByUserRequest{UserId: userId, Page: 2, Limit: 1})
+	s.Suite.Require().NoError(err)
+	s.Suite.Require().Len(orders.Orders, 1)
+	s.Suite.Equal(order.Id, orders.Orders[0].Id)
+}
+
+func (s *Suite) TestOrderTransitions() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	product := s.createProduct(ctx, 5)
+	userId := uuid.New().String()
+
+	place := func() *pb.Order {
+		order, err := s.Repository.CreateOrder(ctx, &pb.CreateOrderRequest{
+			UserId: userId,
+			Items:  []*pb.OrderLine{{ProductId: product.Id, Quantity: 2}},
+		})
+		s.Suite.Require().NoError(err)
+		s.Suite.Equal(pb.OrderStatus_ORDER_PENDING, order.Status)
+		return order
+	}
+	transition := func(orderId int32, status pb.OrderStatus) (*pb.Order, error) {
+		return s.Repository.TransitionOrder(ctx, &pb.TransitionOrderRequest{OrderId: orderId, Status: status})
+	}
+	amount := func() int32 {
+		check, err := s.Repository.CheckAmount(ctx, &pb.GetProductId{ProductId: product.Id})
+		s.Suite.Require().NoError(err)
+		return check.Amount
+	}
+
+	//Happy path up to delivery, stamping each step
+	delivered := place()
+	for _, status := range []pb.OrderStatus{pb.OrderStatus_ORDER_PAID, pb.OrderStatus_ORDER_SHIPPED, pb.OrderStatus_ORDER_DELIVERED} {
+		order, err := transition(delivered.Id, status)
+		s.Suite.Require().NoError(err)
+		s.Suite.Equal(status, order.Status)
+	}
+	got, err := s.Repository.GetOrder(ctx, &pb.GetOrderId{OrderId: delivered.Id})
+	s.Suite.Require().NoError(err)
+	s.Suite.NotEmpty(got.PaidAt)
+	s.Suite.NotEmpty(got.ShippedAt)
+	s.Suite.NotEmpty(got.DeliveredAt)
+	s.Suite.Empty(got.CancelledAt)
+	s.Suite.Equal(int32(3), amount())
+
+	//Delivered orders can no longer be cancelled
+	_, err = transition(delivered.Id, pb.OrderStatus_ORDER_CANCELLED)
+	s.Suite.ErrorIs(err, errs.ErrFailedPrecondition)
+
+	//Cancelling puts the stock back, once
+	cancelled := place()
+	s.Suite.Equal(int32(1), amount())
+
+	order, err := transition(cancelled.Id, pb.OrderStatus_ORDER_CANCELLED)
+	s.Suite.Require().NoError(err)
+	s.Suite.NotEmpty(order.CancelledAt)
+	s.Suite.Equal(int32(3), amount())
+
+	_, err = transition(cancelled.Id, pb.OrderStatus_ORDER_CANCELLED)
+	s.Suite.ErrorIs(err, errs.ErrFailedPrecondition)
+	_, err = transition(cancelled.Id, pb.OrderStatus_ORDER_PAID)
+	s.Suite.ErrorIs(err, errs.ErrFailedPrecondition)
+	s.Suite.Equal(int32(3), amount())
+
+	_, err = transition(missingProductId, pb.OrderStatus_ORDER_PAID)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+}
+
+func (s *Suite) TestReturns() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	product := s.createProduct(ctx, 5)
+	other := s.createProduct(ctx, 5)
+	userId := uuid.New().String()
+
+	order, err := s.Repository.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId: userId,
+		Items:  []*pb.OrderLine{{ProductId: product.Id, Quantity: 3}},
+	})
+	s.Suite.Require().NoError(err)
+
+	returnItem := func(quantity int32, restock bool) (*pb.OrderReturn, error) {
+		return s.Repository.ReturnOrderItem(ctx, &pb.ReturnOrderItemRequest{
+			OrderId:   order.Id,
+			ProductId: product.Id,
+			Quantity:  quantity,
+			Reason:    pb.ReturnReason_RETURN_DAMAGED,
+			Restock:   restock,
+		})
+	}
+	amount := func() int32 {
+		check, err := s.Repository.CheckAmount(ctx, &pb.GetProductId{ProductId: product.Id})
+		s.Suite.Require().NoError(err)
+		return check.Amount
+	}
+
+	//Unpaid orders take no returns
+	_, err = returnItem(1, true)
+	s.Suite.ErrorIs(err, errs.ErrFailedPrecondition)
+
+	_, err = s.Repository.TransitionOrder(ctx, &pb.TransitionOrderRequest{OrderId: order.Id, Status: pb.OrderStatus_ORDER_PAID})
+	s.Suite.Require().NoError(err)
+
+	//A partial return is refunded at the price paid, even after a price change
+	_, err = s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product:    &pb.Product{Id: product.Id, Price: product.Price + 100},
+		UpdateMask: &types.FieldMask{Paths: []string{"price"}},
+	})
+	s.Suite.Require().NoError(err)
+
+	returned, err := returnItem(2, true)
+	s.Suite.Require().NoError(err)
+	s.Suite.NotZero(returned.Id)
+	s.Suite.NotEmpty(returned.CreatedAt)
+	s.Suite.Equal(pb.ReturnReason_RETURN_DAMAGED, returned.Reason)
+	s.Suite.True(returned.Restocked)
+	s.Suite.InDelta(2*product.Price, returned.RefundAmount, 0.01)
+	s.Suite.Equal(int32(4), amount())
+
+	//Returns cannot exceed what was bought
+	_, err = returnItem(2, false)
+	s.Suite.ErrorIs(err, errs.ErrFailedPrecondition)
+
+	//Without restock the stock stays as it is
+	_, err = returnItem(1, false)
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int32(4), amount())
+
+	_, err = s.Repository.ReturnOrderItem(ctx, &pb.ReturnOrderItemRequest{OrderId: order.Id, ProductId: other.Id, Quantity: 1})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	//Purchase history shows net quantities
+	purchased, err := s.Repository.GetPurchasedProductsByUserId(ctx, &pb.GetUserID{UserId: userId})
+	s.Suite.Require().NoError(err)
+	s.Suite.Require().Len(purchased.Purchases, 1)
+	s.Suite.Equal(order.Id, purchased.Purchases[0].OrderId)
+	s.Suite.Equal(int32(3), purchased.Purchases[0].Quantity)
+	s.Suite.Equal(int32(3), purchased.Purchases[0].ReturnedQuantity)
+	s.Suite.Equal(int32(0), purchased.Purchases[0].NetQuantity)
+	s.Suite.Equal(product.Price, purchased.Purchases[0].UnitPrice)
+
+	//Cancelling after returns only restocks what was not returned
+	cancelled, err := s.Repository.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId: userId,
+		Items:  []*pb.OrderLine{{ProductId: product.Id, Quantity: 2}, {ProductId: other.Id, Quantity: 1}},
+	})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.TransitionOrder(ctx, &pb.TransitionOrderRequest{OrderId: cancelled.Id, Status: pb.OrderStatus_ORDER_PAID})
+	s.Suite.Require().NoError(err)
+
+	_, err = s.Repository.ReturnOrderItem(ctx, &pb.ReturnOrderItemRequest{OrderId: cancelled.Id, ProductId: product.Id, Quantity: 1, Restock: true})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.ReturnOrderItem(ctx, &pb.ReturnOrderItemRequest{OrderId: cancelled.Id, ProductId: other.Id, Quantity: 1})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int32(3), amount())
+
+	_, err = s.Repository.TransitionOrder(ctx, &pb.TransitionOrderRequest{OrderId: cancelled.Id, Status: pb.OrderStatus_ORDER_CANCELLED})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int32(4), amount())
+
+	otherAmount, err := s.Repository.CheckAmount(ctx, &pb.GetProductId{ProductId: other.Id})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int32(4), otherAmount.Amount)
+
+	//Returns restock products deleted since the sale
+	delivered, err := s.Repository.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId: userId,
+		Items:  []*pb.OrderLine{{ProductId: other.Id, Quantity: 2}},
+	})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.TransitionOrder(ctx, &pb.TransitionOrderRequest{OrderId: delivered.Id, Status: pb.OrderStatus_ORDER_PAID})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.DeleteProduct(ctx, &pb.GetProductId{ProductId: other.Id})
+	s.Suite.Require().NoError(err)
+
+	_, err = s.Repository.ReturnOrderItem(ctx, &pb.ReturnOrderItemRequest{OrderId: delivered.Id, ProductId: other.Id, Quantity: 1, Restock: true})
+	s.Suite.Require().NoError(err)
+
+	deleted, err := s.Repository.GetProductById(ctx, &pb.GetProductId{ProductId: other.Id, IncludeDeleted: true})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int32(3), deleted.Amount)
+
+	movements, err := s.Repository.ListStockMovements(ctx, &pb.ListStockMovementsRequest{ProductId: other.Id, Page: 1, Limit: 100})
+	s.Suite.Require().NoError(err)
+	s.Suite.Require().NotEmpty(movements.Movements)
+	last := movements.Movements[len(movements.Movements)-1]
+	s.Suite.Equal(pb.StockMovementReason_MOVEMENT_RETURN, last.Reason)
+	s.Suite.Equal(int32(1), last.Delta)
+	s.Suite.Equal(int32(3), last.Balance)
+}
+
+func (s *Suite) TestReservations() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	product := s.createProduct(ctx, 5)
+
+	reserve := func(quantity, ttlSeconds int32) (*pb.Reservation, error) {
+		return s.Repository.ReserveStock(ctx, &pb.ReserveStockRequest{
+			ProductId:  product.Id,
+			Quantity:   quantity,
+			TtlSeconds: ttlSeconds,
+		})
+	}
+	check := func() *pb.CheckAmountResponse {
+		check, err := s.Repository.CheckAmount(ctx, &pb.GetProductId{ProductId: product.Id})
+		s.Suite.Require().NoError(err)
+		return check
+	}
+
+	//Reserved stock is not available to anyone else
+	committed, err := reserve(2, 0)
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(pb.ReservationStatus_RESERVATION_ACTIVE, committed.Status)
+	s.Suite.NotEmpty(committed.ExpiresAt)
+
+	released, err := reserve(2, 0)
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int32(1), check().Amount)
+	s.Suite.Equal(int32(4), check().Reserved)
+
+	_, err = reserve(2, 0)
+	s.Suite.ErrorIs(err, errs.ErrInsufficientStock)
+	_, err = s.Repository.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 2})
+	s.Suite.ErrorIs(err, errs.ErrInsufficientStock)
+
+	//Committing takes the stock for good, releasing gives it back
+	got, err := s.Repository.CommitReservation(ctx, &pb.GetReservationId{ReservationId: committed.Id})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(pb.ReservationStatus_RESERVATION_COMMITTED, got.Status)
+
+	got, err = s.Repository.ReleaseReservation(ctx, &pb.GetReservationId{ReservationId: released.Id})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(pb.ReservationStatus_RESERVATION_RELEASED, got.Status)
+	s.Suite.Equal(int32(3), check().Amount)
+	s.Suite.Equal(int32(0), check().Reserved)
+
+	_, err = s.Repository.ReleaseReservation(ctx, &pb.GetReservationId{ReservationId: committed.Id})
+	s.Suite.ErrorIs(err, errs.ErrFailedPrecondition)
+	_, err = s.Repository.CommitReservation(ctx, &pb.GetReservationId{ReservationId: missingProductId})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	//The sweeper releases reservations past their TTL, which can no longer be committed
+	expired, err := reserve(3, 1)
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int32(0), check().Amount)
+
+	later := time.Now().Add(2 * time.Second)
+	count, err := s.Repository.ReleaseExpiredReservations(ctx, later)
+	s.Suite.Require().NoError(err)
+	s.Suite.GreaterOrEqual(count, int64(1))
+	s.Suite.Equal(int32(3), check().Amount)
+
+	_, err = s.Repository.CommitReservation(ctx, &pb.GetReservationId{ReservationId: expired.Id})
+	s.Suite.ErrorIs(err, errs.ErrFailedPrecondition)
+
+	//Commits never take the amount below zero, even when it was lowered under the hold
+	held, err := reserve(2, 0)
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product:    &pb.Product{Id: product.Id, Amount: 1},
+		UpdateMask: &types.FieldMask{Paths: []string{"amount"}},
+	})
+	s.Suite.Require().NoError(err)
+
+	_, err = s.Repository.CommitReservation(ctx, &pb.GetReservationId{ReservationId: held.Id})
+	s.Suite.ErrorIs(err, errs.ErrInsufficientStock)
+
+	got, err = s.Repository.ReleaseReservation(ctx, &pb.GetReservationId{ReservationId: held.Id})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(pb.ReservationStatus_RESERVATION_RELEASED, got.Status)
+	s.Suite.Equal(int32(1), check().Amount)
+}
+
+func (s *Suite) TestStockLedger() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	start := time.Now().Add(-time.Second)
+	product := s.createProduct(ctx, 10)
+	ctx = actor.With(ctx, "alice")
+	userId := uuid.New().String()
+
+	//Every change of the amount leaves an entry
+	_, err := s.Repository.IncreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 5})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 2})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product:    &pb.Product{Id: product.Id, Amount: 20},
+		UpdateMask: &types.FieldMask{Paths: []string{"amount"}},
+	})
+	s.Suite.Require().NoError(err)
+
+	sold, err := s.Repository.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId: userId,
+		Items:  []*pb.OrderLine{{ProductId: product.Id, Quantity: 3}},
+	})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.TransitionOrder(ctx, &pb.TransitionOrderRequest{OrderId: sold.Id, Status: pb.OrderStatus_ORDER_PAID})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.ReturnOrderItem(ctx, &pb.ReturnOrderItemRequest{
+		OrderId: sold.Id, ProductId: product.Id, Quantity: 1, Restock: true,
+	})
+	s.Suite.Require().NoError(err)
+
+	cancelled, err := s.Repository.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId: userId,
+		Items:  []*pb.OrderLine{{ProductId: product.Id, Quantity: 2}},
+	})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.TransitionOrder(ctx, &pb.TransitionOrderRequest{OrderId: cancelled.Id, Status: pb.OrderStatus_ORDER_CANCELLED})
+	s.Suite.Require().NoError(err)
+
+	reservation, err := s.Repository.ReserveStock(ctx, &pb.ReserveStockRequest{ProductId: product.Id, Quantity: 4})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.CommitReservation(ctx, &pb.GetReservationId{ReservationId: reservation.Id})
+	s.Suite.Require().NoError(err)
+
+	list := func(from, to string, page, limit int32) *pb.ListStockMovementsResponse {
+		movements, err := s.Repository.ListStockMovements(ctx, &pb.ListStockMovementsRequest{
+			ProductId: product.Id, From: from, To: to, Page: page, Limit: limit,
+		})
+		s.Suite.Require().NoError(err)
+		return movements
+	}
+
+	all := list("", "", 1, 100)
+	s.Suite.Equal(int64(9), all.TotalCount)
+	s.Suite.Require().Len(all.Movements, 9)
+
+	expected := []struct {
+		reason    pb.StockMovementReason
+		delta     int32
+		balance   int32
+		reference string
+	}{
+		{pb.StockMovementReason_MOVEMENT_OPENING, 10, 10, ""},
+		{pb.StockMovementReason_MOVEMENT_INCREASE, 5, 15, ""},
+		{pb.StockMovementReason_MOVEMENT_DECREASE, -2, 13, ""},
+		{pb.StockMovementReason_MOVEMENT_ADJUSTMENT, 7, 20, ""},
+		{pb.StockMovementReason_MOVEMENT_SALE, -3, 17, repo.OrderRef(sold.Id)},
+		{pb.StockMovementReason_MOVEMENT_RETURN, 1, 18, repo.OrderRef(sold.Id)},
+		{pb.StockMovementReason_MOVEMENT_SALE, -2, 16, repo.OrderRef(cancelled.Id)},
+		{pb.StockMovementReason_MOVEMENT_CANCELLATION, 2, 18, repo.OrderRef(cancelled.Id)},
+		{pb.StockMovementReason_MOVEMENT_RESERVATION, -4, 14, repo.ReservationRef(reservation.Id)},
+	}
+	for i, want := range expected {
+		got := all.Movements[i]
+		s.Suite.Equal(want.reason, got.Reason, "movement %d", i)
+		s.Suite.Equal(want.delta, got.Delta, "movement %d", i)
+		s.Suite.Equal(want.balance, got.Balance, "movement %d", i)
+		s.Suite.Equal(want.reference, got.Reference, "movement %d", i)
+		s.Suite.NotEmpty(got.CreatedAt)
+	}
+	s.Suite.Equal(actor.System, all.Movements[0].Actor)
+	s.Suite.Equal("alice", all.Movements[1].Actor)
+
+	//Pages and time ranges narrow the history
+	page := list("", "", 2, 4)
+	s.Suite.Equal(int64(9), page.TotalCount)
+	s.Suite.Require().Len(page.Movements, 4)
+	s.Suite.Equal(all.Movements[4].Id, page.Movements[0].Id)
+
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	s.Suite.Len(list(start.UTC().Format(time.RFC3339Nano), future, 1, 100).Movements, 9)
+	s.Suite.Empty(list(future, "", 1, 100).Movements)
+	s.Suite.Empty(list("", start.UTC().Format(time.RFC3339Nano), 1, 100).Movements)
+
+	//The ledger adds up to the stock
+	reconciled, err := s.Repository.ReconcileStock(ctx, &pb.ReconcileStockRequest{ProductId: product.Id})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int64(1), reconciled.Checked)
+	s.Suite.Empty(reconciled.Discrepancies)
+
+	reconciled, err = s.Repository.ReconcileStock(ctx, &pb.ReconcileStockRequest{})
+	s.Suite.Require().NoError(err)
+	s.Suite.GreaterOrEqual(reconciled.Checked, int64(1))
+	s.Suite.Empty(reconciled.Discrepancies)
+}
+
+func (s *Suite) TestSoftDelete() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	product := s.createProduct(ctx, 5)
+	productId := &pb.GetProductId{ProductId: product.Id}
+	userId := uuid.New().String()
+
+	_, err := s.Repository.BuyProduct(ctx, &pb.BuyProductRequest{UserId: userId, ProductId: product.Id, Amount: 1})
+	s.Suite.NoError(err)
+
+	//Delete only hides the product
+	_, err = s.Repository.DeleteProduct(ctx, productId)
+	s.Suite.NoError(err)
+
+	_, err = s.Repository.GetProductById(ctx, productId)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	deleted, err := s.Repository.GetProductById(ctx, &pb.GetProductId{ProductId: product.Id, IncludeDeleted: true})
+	s.Suite.NoError(err)
+	s.Suite.NotEmpty(deleted.Deleted)
+
+	s.Suite.False(s.listed(ctx, product.Id, false))
+	s.Suite.True(s.listed(ctx, product.Id, true))
+
+	_, err = s.Repository.DeleteProduct(ctx, productId)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	_, err = s.Repository.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 1})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	_, err = s.Repository.BuyProduct(ctx, &pb.BuyProductRequest{UserId: userId, ProductId: product.Id, Amount: 1})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	//Purchase history still shows it
+	purchased, err := s.Repository.GetPurchasedProductsByUserId(ctx, &pb.GetUserID{UserId: userId})
+	s.Suite.NoError(err)
+	s.Suite.Len(purchased.Products, 1)
+
+	//Restore
+	restored, err := s.Repository.RestoreProduct(ctx, productId)
+	s.Suite.NoError(err)
+	s.Suite.Empty(restored.Deleted)
+
+	got, err := s.Repository.GetProductById(ctx, productId)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int32(4), got.Amount)
+
+	//Purge
+	unsold := s.createProduct(ctx, 5)
+	unsoldId := &pb.GetProductId{ProductId: unsold.Id, IncludeDeleted: true}
+	_, err = s.Repository.DeleteProduct(ctx, unsoldId)
+	s.Suite.NoError(err)
+	_, err = s.Repository.DeleteProduct(ctx, productId)
+	s.Suite.NoError(err)
+
+	_, err = s.Repository.PurgeDeletedProducts(ctx, &pb.PurgeDeletedProductsRequest{
+		OlderThan: time.Now().Add(-time.Hour).Format(time.RFC3339),
+	})
+	s.Suite.NoError(err)
+
+	_, err = s.Repository.GetProductById(ctx, unsoldId)
+	s.Suite.NoError(err, "recently deleted product must survive the purge")
+
+	purged, err := s.Repository.PurgeDeletedProducts(ctx, &pb.PurgeDeletedProductsRequest{
+		OlderThan: time.Now().Add(time.Hour).Format(time.RFC3339),
+	})
+	s.Suite.NoError(err)
+	s.Suite.GreaterOrEqual(purged.Purged, int64(1))
+
+	_, err = s.Repository.GetProductById(ctx, unsoldId)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	_, err = s.Repository.RestoreProduct(ctx, unsoldId)
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	//Purchased products survive the purge with their history
+	_, err = s.Repository.GetProductById(ctx, &pb.GetProductId{ProductId: product.Id, IncludeDeleted: true})
+	s.Suite.NoError(err)
+
+	purchased, err = s.Repository.GetPurchasedProductsByUserId(ctx, &pb.GetUserID{UserId: userId})
+	s.Suite.NoError(err)
+	s.Suite.Len(purchased.Products, 1)
+	s.Suite.Len(purchased.Purchases, 1)
+}
+
+func (s *Suite) TestOptimisticConcurrency() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	product := s.createProduct(ctx, 5)
+	s.Suite.Equal(int64(1), product.Version)
+
+	//Update with the current version
+	updated, err := s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product:    &pb.Product{Id: product.Id, Price: 20, Version: product.Version},
+		UpdateMask: &types.FieldMask{Paths: []string{"price"}},
+	})
+	s.Suite.Require().NoError(err)
+	s.Suite.Equal(int64(2), updated.Version)
+
+	//A stale version is rejected and nothing is written
+	_, err = s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product:    &pb.Product{Id: product.Id, Price: 30, Version: product.Version},
+		UpdateMask: &types.FieldMask{Paths: []string{"price"}},
+	})
+	s.Suite.ErrorIs(err, errs.ErrConflict)
+
+	got, err := s.Repository.GetProductById(ctx, &pb.GetProductId{ProductId: product.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(float32(20), got.Price)
+	s.Suite.Equal(int64(2), got.Version)
+
+	//Stock changes check the expected version too
+	_, err = s.Repository.IncreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 1, ExpectedVersion: 1})
+	s.Suite.ErrorIs(err, errs.ErrConflict)
+
+	_, err = s.Repository.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 1, ExpectedVersion: 1})
+	s.Suite.ErrorIs(err, errs.ErrConflict)
+
+	increased, err := s.Repository.IncreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 1, ExpectedVersion: 2})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(3), increased.Product.Version)
+
+	//Zero skips the check
+	decreased, err := s.Repository.DecreaseProductAmount(ctx, &pb.ProductAmountRequest{ProductId: product.Id, AmountBy: 1})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int32(5), decreased.Product.Amount)
+	s.Suite.Equal(int64(4), decreased.Product.Version)
+
+	//Missing products are still not found
+	_, err = s.Repository.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		Product: &pb.Product{Id: missingProductId, Name: "x", Version: 1},
+	})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+}
+
+func (s *Suite) TestCategories() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	root := s.createCategory(ctx, 0)
+	child := s.createCategory(ctx, root.Id)
+	grandchild := s.createCategory(ctx, child.Id)
+	other := s.createCategory(ctx, 0)
+
+	got, err := s.Repository.GetCategory(ctx, &pb.GetCategoryId{CategoryId: grandchild.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(child.Id, got.ParentId)
+	s.Suite.Equal(grandchild.Slug, got.Slug)
+
+	//Slugs are unique and parents must exist
+	_, err = s.Repository.CreateCategory(ctx, &pb.Category{Name: "Copy", Slug: root.Slug})
+	s.Suite.ErrorIs(err, errs.ErrAlreadyExists)
+
+	_, err = s.Repository.CreateCategory(ctx, &pb.Category{Name: "Orphan", Slug: s.slug(), ParentId: missingProductId})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	//Children and descendants
+	children, err := s.Repository.ListCategories(ctx, &pb.ListCategoriesRequest{ParentId: root.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{child.Id}, categoryIds(children.Categories))
+
+	descendants, err := s.Repository.ListCategories(ctx, &pb.ListCategoriesRequest{ParentId: root.Id, Recursive: true})
+	s.Suite.NoError(err)
+	s.Suite.ElementsMatch([]int32{child.Id, grandchild.Id}, categoryIds(descendants.Categories))
+
+	//A category cannot move under its own subtree
+	_, err = s.Repository.UpdateCategory(ctx, &pb.Category{Id: root.Id, Name: root.Name, Slug: root.Slug, ParentId: grandchild.Id})
+	s.Suite.ErrorIs(err, errs.ErrInvalidArgument)
+
+	//Listing by category includes descendants
+	inGrandchild := s.createProduct(ctx, 1)
+	inOther := s.createProduct(ctx, 1)
+
+	assigned, err := s.Repository.SetProductCategories(ctx, &pb.ProductCategoriesRequest{ProductId: inGrandchild.Id, CategoryIds: []int32{grandchild.Id}})
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{grandchild.Id}, categoryIds(assigned.Categories))
+
+	_, err = s.Repository.SetProductCategories(ctx, &pb.ProductCategoriesRequest{ProductId: inOther.Id, CategoryIds: []int32{other.Id}})
+	s.Suite.NoError(err)
+
+	_, err = s.Repository.SetProductCategories(ctx, &pb.ProductCategoriesRequest{ProductId: inOther.Id, CategoryIds: []int32{missingProductId}})
+	s.Suite.ErrorIs(err, errs.ErrNotFound)
+
+	underRoot, err := s.Repository.ListProducts(ctx, &pb.GetListRequest{Page: 1, Limit: 10, CategoryId: root.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{inGrandchild.Id}, productIds(underRoot.Products))
+
+	//Only leaves can be deleted, taking their assignments along
+	_, err = s.Repository.DeleteCategory(ctx, &pb.GetCategoryId{CategoryId: child.Id})
+	s.Suite.ErrorIs(err, errs.ErrFailedPrecondition)
+
+	_, err = s.Repository.DeleteCategory(ctx, &pb.GetCategoryId{CategoryId: grandchild.Id})
+	s.Suite.NoError(err)
+
+	categories, err := s.Repository.GetProductCategories(ctx, &pb.GetProductId{ProductId: inGrandchild.Id})
+	s.Suite.NoError(err)
+	s.Suite.Empty(categories.Categories)
+
+	//Moving to the top level
+	moved, err := s.Repository.UpdateCategory(ctx, &pb.Category{Id: child.Id, Name: "Moved", Slug: child.Slug, Position: 3})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int32(0), moved.ParentId)
+	s.Suite.Equal("Moved", moved.Name)
+	s.Suite.NotEmpty(moved.UpdatedAt)
+
+	//Concurrent moves of two categories under each other never close a loop
+	first, second := s.createCategory(ctx, 0), s.createCategory(ctx, 0)
+	var (
+		wg    sync.WaitGroup
+		moves int32
+	)
+	for _, move := range [][2]*pb.Category{{first, second}, {second, first}} {
+		wg.Add(1)
+		go func(category, parent *pb.Category) {
+			defer wg.Done()
+			_, err := s.Repository.UpdateCategory(ctx, &pb.Category{
+				Id: category.Id, Name: category.Name, Slug: category.Slug, ParentId: parent.Id,
+			})
+			if err == nil {
+				atomic.AddInt32(&moves, 1)
+			}
+		}(move[0], move[1])
+	}
+	wg.Wait()
+	s.Suite.Equal(int32(1), moves)
+
+	_, err = s.Repository.ListProducts(ctx, &pb.GetListRequest{Page: 1, Limit: 10, CategoryId: first.Id})
+	s.Suite.NoError(err)
+}
+
+func (s *Suite) TestListFilters() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	//Products are scoped to a fresh category so other tests don't leak in
+	category := s.createCategory(ctx, 0)
+	marker := s.slug()
+
+	create := func(name string, price float32, amount int32) *pb.Product {
+		product, err := s.Repository.CreateProduct(ctx, &pb.Product{Name: name + " " + marker, Price: price, Amount: amount})
+		s.Suite.Require().NoError(err)
+		_, err = s.Repository.SetProductCategories(ctx, &pb.ProductCategoriesRequest{ProductId: product.Id, CategoryIds: []int32{category.Id}})
+		s.Suite.Require().NoError(err)
+		return product
+	}
+
+	soldOut := create("Kettle", 5, 0)
+	cheap := create("Teapot", 7, 3)
+	cheapToo := create("Toaster", 7, 2)
+	pricey := create("Blender", 20, 1)
+
+	list := func(req *pb.GetListRequest) []int32 {
+		req.Page, req.Limit, req.CategoryId = 1, 10, category.Id
+		response, err := s.Repository.ListProducts(ctx, req)
+		s.Suite.Require().NoError(err)
+		return productIds(response.Products)
+	}
+
+	s.Suite.Equal([]int32{cheap.Id, cheapToo.Id}, list(&pb.GetListRequest{MinPrice: 6, MaxPrice: 10, InStock: true}))
+	s.Suite.Equal([]int32{soldOut.Id, cheap.Id, cheapToo.Id, pricey.Id}, list(&pb.GetListRequest{Name: marker}))
+	s.Suite.Equal([]int32{cheap.Id}, list(&pb.GetListRequest{Name: "TEAPOT"}))
+
+	//Ties on the sort column fall back to id in the same direction
+	s.Suite.Equal([]int32{pricey.Id, cheapToo.Id, cheap.Id, soldOut.Id}, list(&pb.GetListRequest{SortBy: pb.SortField_SORT_PRICE, Descending: true}))
+	s.Suite.Equal([]int32{pricey.Id, soldOut.Id, cheap.Id, cheapToo.Id}, list(&pb.GetListRequest{SortBy: pb.SortField_SORT_NAME}))
+	s.Suite.Equal([]int32{soldOut.Id, pricey.Id, cheapToo.Id, cheap.Id}, list(&pb.GetListRequest{SortBy: pb.SortField_SORT_AMOUNT}))
+
+	//Totals count every matching product, not just the page
+	firstPage, err := s.Repository.ListProducts(ctx, &pb.GetListRequest{Page: 1, Limit: 3, CategoryId: category.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(3), firstPage.Count)
+	s.Suite.Equal(int64(4), firstPage.TotalCount)
+	s.Suite.Equal(int32(2), firstPage.TotalPages)
+	s.Suite.True(firstPage.HasNext)
+
+	lastPage, err := s.Repository.ListProducts(ctx, &pb.GetListRequest{Page: 2, Limit: 3, CategoryId: category.Id, InStock: true})
+	s.Suite.NoError(err)
+	s.Suite.Empty(lastPage.Products)
+	s.Suite.Equal(int64(3), lastPage.TotalCount)
+	s.Suite.Equal(int32(1), lastPage.TotalPages)
+	s.Suite.False(lastPage.HasNext)
+
+	//Page tokens continue after the last product even when the catalog changes
+	byPrice := &pb.GetListRequest{Page: 1, Limit: 3, CategoryId: category.Id, SortBy: pb.SortField_SORT_PRICE, Descending: true}
+	first, err := s.Repository.ListProducts(ctx, byPrice)
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{pricey.Id, cheapToo.Id, cheap.Id}, productIds(first.Products))
+	s.Suite.NotEmpty(first.NextPageToken)
+
+	create("Grinder", 30, 1)
+
+	byPrice.PageToken = first.NextPageToken
+	second, err := s.Repository.ListProducts(ctx, byPrice)
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{soldOut.Id}, productIds(second.Products))
+	s.Suite.Empty(second.NextPageToken)
+	s.Suite.False(second.HasNext)
+
+	byPrice.Descending = false
+	_, err = s.Repository.ListProducts(ctx, byPrice)
+	s.Suite.ErrorIs(err, errs.ErrInvalidArgument)
+
+	//Facets cover every matching product, not just the page
+	faceted, err := s.Repository.ListProducts(ctx, &pb.GetListRequest{
+		Page: 1, Limit: 1, CategoryId: category.Id,
+		IncludeFacets: true, PriceBounds: []float32{10, 25},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Require().NotNil(faceted.Facets)
+	s.Suite.Equal([]*pb.CategoryFacet{{CategoryId: category.Id, Name: category.Name, Count: 5}}, faceted.Facets.Categories)
+	s.Suite.Equal([]*pb.PriceBucket{
+		{Min: 0, Max: 10, Count: 3},
+		{Min: 10, Max: 25, Count: 1},
+		{Min: 25, Max: 0, Count: 1},
+	}, faceted.Facets.PriceBuckets)
+	s.Suite.Equal(int64(4), faceted.Facets.InStock)
+	s.Suite.Equal(int64(1), faceted.Facets.OutOfStock)
+	s.Suite.Len(faceted.Products, 1)
+
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	s.Suite.Empty(list(&pb.GetListRequest{CreatedAfter: future}))
+	s.Suite.Len(list(&pb.GetListRequest{CreatedBefore: future}), 5)
+
+	_, err = s.Repository.ListProducts(ctx, &pb.GetListRequest{Page: 1, Limit: 10, CreatedAfter: "yesterday"})
+	s.Suite.ErrorIs(err, errs.ErrInvalidArgument)
+
+	//Fully reserved products are out of stock
+	_, err = s.Repository.ReserveStock(ctx, &pb.ReserveStockRequest{ProductId: pricey.Id, Quantity: 1})
+	s.Suite.Require().NoError(err)
+	s.Suite.Empty(list(&pb.GetListRequest{MinPrice: 15, MaxPrice: 25, InStock: true}))
+
+	faceted, err = s.Repository.ListProducts(ctx, &pb.GetListRequest{Page: 1, Limit: 1, CategoryId: category.Id, IncludeFacets: true})
+	s.Suite.NoError(err)
+	s.Suite.Require().NotNil(faceted.Facets)
+	s.Suite.Equal(int64(3), faceted.Facets.InStock)
+	s.Suite.Equal(int64(2), faceted.Facets.OutOfStock)
+}
+
+func (s *Suite) TestSearch() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	//A made-up word keeps products of other tests out of the results
+	word := strings.ToLower(gofakeit.LetterN(10))
+
+	inName, err := s.Repository.CreateProduct(ctx, &pb.Product{Name: "Steel " + word, Description: "A kettle", Price: 30})
+	s.Suite.Require().NoError(err)
+	inDescription, err := s.Repository.CreateProduct(ctx, &pb.Product{Name: "Teapot", Description: "Goes well with " + word, Price: 20})
+	s.Suite.Require().NoError(err)
+	deleted, err := s.Repository.CreateProduct(ctx, &pb.Product{Name: "Old " + word, Price: 10})
+	s.Suite.Require().NoError(err)
+	_, err = s.Repository.DeleteProduct(ctx, &pb.GetProductId{ProductId: deleted.Id})
+	s.Suite.Require().NoError(err)
+
+	//Name matches rank above description matches
+	found, err := s.Repository.SearchProducts(ctx, &pb.SearchProductsRequest{Query: word, Page: 1, Limit: 10})
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{inName.Id, inDescription.Id}, hitIds(found.Hits))
+	s.Suite.Equal(int64(2), found.TotalCount)
+	s.Suite.Equal("Steel <em>"+word+"</em>", found.Hits[0].NameHighlight)
+	s.Suite.Equal("Goes well with <em>"+word+"</em>", found.Hits[1].DescriptionHighlight)
+	s.Suite.Greater(found.Hits[0].Score, found.Hits[1].Score)
+
+	faceted, err := s.Repository.SearchProducts(ctx, &pb.SearchProductsRequest{Query: word, Page: 1, Limit: 1, IncludeFacets: true})
+	s.Suite.NoError(err)
+	s.Suite.Require().NotNil(faceted.Facets)
+	s.Suite.Equal(int64(2), faceted.Facets.OutOfStock)
+	s.Suite.Len(faceted.Facets.PriceBuckets, len(repo.DefaultPriceBounds)+1)
+
+	//Same paging as ListProducts
+	first, err := s.Repository.SearchProducts(ctx, &pb.SearchProductsRequest{Query: word, Page: 1, Limit: 1})
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{inName.Id}, hitIds(first.Hits))
+	s.Suite.True(first.HasNext)
+
+	second, err := s.Repository.SearchProducts(ctx, &pb.SearchProductsRequest{Query: word, Limit: 1, PageToken: first.NextPageToken})
+	s.Suite.NoError(err)
+	s.Suite.Equal([]int32{inDescription.Id}, hitIds(second.Hits))
+	s.Suite.False(second.HasNext)
+
+	//A typo in a short query still finds the product
+	typo := word[:9] + "a"
+	if word[9] == 'a' {
+		typo = word[:9] + "b"
+	}
+	fuzzy, err := s.Repository.SearchProducts(ctx, &pb.SearchProductsRequest{Query: typo, Page: 1, Limit: 10})
+	s.Suite.NoError(err)
+	s.Suite.Require().NotEmpty(fuzzy.Hits)
+	s.Suite.Equal(inName.Id, fuzzy.Hits[0].Product.Id)
+
+	_, err = s.Repository.SearchProducts(ctx, &pb.SearchProductsRequest{Query: "-" + word, Page: 1, Limit: 10})
+	s.Suite.ErrorIs(err, errs.ErrInvalidArgument)
+}
+
+func (s *Suite) TestSuggest() {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	word := strings.ToLower(gofakeit.LetterN(8))
+
+	create := func(name string, amount int32) *pb.Product {
+		product, err := s.Repository.CreateProduct(ctx, &pb.Product{Name: name, Price: 10, Amount: amount})
+		s.Suite.Require().NoError(err)
+		return product
+	}
+
+	kettle := create(strings.ToUpper(word[:1])+word[1:]+" kettle", 1)
+	jug := create(word+" jug", 0)
+	lamp := create(word+" lamp", 1)
+	create("Other "+word, 1)
+
+	_, err := s.Repository.DeleteProduct(ctx, &pb.GetProductId{ProductId: lamp.Id})
+	s.Suite.Require().NoError(err)
+
+	suggest := func(req *pb.SuggestProductsRequest) []int32 {
+		response, err := s.Repository.SuggestProducts(ctx, req)
+		s.Suite.Require().NoError(err)
+
+		var ids []int32
+		for _, suggestion := range response.Suggestions {
+			ids = append(ids, suggestion.ProductId)
+		}
+		return ids
+	}
+
+	s.Suite.Equal([]int32{jug.Id, kettle.Id}, suggest(&pb.SuggestProductsRequest{Prefix: strings.ToUpper(word), Limit: 10}))
+	s.Suite.Equal([]int32{kettle.Id}, suggest(&pb.SuggestProductsRequest{Prefix: word, Limit: 10, InStock: true}))
+	s.Suite.Equal([]int32{jug.Id}, suggest(&pb.SuggestProductsRequest{Prefix: word, Limit: 1}))
+	s.Suite.Empty(suggest(&pb.SuggestProductsRequest{Prefix: word + "%", Limit: 10}))
+}
+
+func (s *Suite) createCategory(ctx context.Context, parentId int32) *pb.Category {
+	category, err := s.Repository.CreateCategory(ctx, &pb.Category{
+		ParentId: parentId,
+		Name:     gofakeit.ProductCategory(),
+		Slug:     s.slug(),
+	})
+	s.Suite.Require().NoError(err)
+	s.Suite.Require().NotZero(category.Id)
+
+	return category
+}
+
+// slug is unique across runs, since backends may share a database
+func (s *Suite) slug() string {
+	return "category-" + strings.ReplaceAll(uuid.New().String(), "-", "")
+}
+
+func categoryIds(categories []*pb.Category) []int32 {
+	ids := make([]int32, 0, len(categories))
+	for _, category := range categories {
+		ids = append(ids, category.Id)
+	}
+
+	return ids
+}
+
+func productIds(products []*pb.Product) []int32 {
+	ids := make([]int32, 0, len(products))
+	for _, product := range products {
+		ids = append(ids, product.Id)
+	}
+
+	return ids
+}
+
+func hitIds(hits []*pb.SearchHit) []int32 {
+	ids := make([]int32, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.Product.Id)
+	}
+
+	return ids
+}
+
+// listed walks every page of ListProducts looking for id
+func (s *Suite) listed(ctx context.Context, id int32, includeDeleted bool) bool {
+	const limit = 50
+
+	for page := int32(1); ; page++ {
+		resp, err := s.Repository.ListProducts(ctx, &pb.GetListRequest{
+			Page:           page,
+			Limit:          limit,
+			IncludeDeleted: includeDeleted,
+		})
+		s.Suite.Require().NoError(err)
+
+		for _, product := range resp.Products {
+			if product.Id == id {
+				return true
+			}
+		}
+
+		if len(resp.Products) < limit {
+			return false
+		}
+	}
+}
@@ -1,28 +1,141 @@
 package storage
 
 import (
-	// "exam/product-service/pkg/db"
+	"context"
+	"exam/product-service/config"
+	"exam/product-service/migrations"
+	"exam/product-service/pkg/db"
 	"exam/product-service/pkg/logger"
-	// "exam/product-service/storage/postgres"
+	"exam/product-service/pkg/migrate"
+	"exam/product-service/storage/memory"
 	mon "exam/product-service/storage/mongo"
+	"exam/product-service/storage/postgres"
 	"exam/product-service/storage/repo"
+	"fmt"
+	"time"
+)
 
-	"go.mongodb.org/mongo-driver/mongo"
+// Storage drivers accepted in config.Config.StorageDriver
+const (
+	DriverPostgres = "postgres"
+	DriverMongo    = "mongo"
+	DriverMemory   = "memory"
 )
 
+// migrateOnStartTimeout bounds the migrations run while booting
+const migrateOnStartTimeout = 5 * time.Minute
+
 // Storage
 type StorageI interface {
 	ProductService() repo.ProductServiceI
+	Close()
 }
 
-type storagePg struct {
+type storage struct {
 	productService repo.ProductServiceI
+	close          func()
+}
+
+// New connects to the backend selected by cfg.StorageDriver
+func New(cfg config.Config, log logger.Logger) (StorageI, error) {
+	switch cfg.StorageDriver {
+	case DriverPostgres:
+		pg, err := db.New(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("cannot connect to postgres: %w", err)
+		}
+
+		driver, err := migrate.NewPostgres(pg, migrations.FS)
+		if err == nil {
+			err = migrateOnStart(cfg, log, driver)
+		}
+		if err != nil {
+			pg.Close()
+			return nil, err
+		}
+
+		return &storage{
+			productService: postgres.NewProductRepo(pg, log),
+			close:          pg.Close,
+		}, nil
+	case DriverMongo:
+		database, err := db.NewMongo(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("cannot connect to mongo: %w", err)
+		}
+
+		if err := migrateOnStart(cfg, log, migrate.NewMongo(database, mon.Migrations)); err != nil {
+			_ = database.Client().Disconnect(context.Background())
+			return nil, err
+		}
+
+		return &storage{
+			productService: mon.NewProductRepo(database, log),
+			close: func() {
+				_ = database.Client().Disconnect(context.Background())
+			},
+		}, nil
+	case DriverMemory:
+		return &storage{
+			productService: memory.NewProductRepo(log),
+			close:          func() {},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.StorageDriver)
+	}
+}
+
+// Migrator connects to the backend selected by cfg.StorageDriver and
+// returns the migrator of its schema along with a func closing the connection
+func Migrator(cfg config.Config, log logger.Logger) (*migrate.Migrator, func(), error) {
+	switch cfg.StorageDriver {
+	case DriverPostgres:
+		pg, err := db.New(cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot connect to postgres: %w", err)
+		}
+
+		driver, err := migrate.NewPostgres(pg, migrations.FS)
+		if err != nil {
+			pg.Close()
+			return nil, nil, err
+		}
+
+		return migrate.New(driver, log), pg.Close, nil
+	case DriverMongo:
+		database, err := db.NewMongo(cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot connect to mongo: %w", err)
+		}
+
+		return migrate.New(migrate.NewMongo(database, mon.Migrations), log), func() {
+			_ = database.Client().Disconnect(context.Background())
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("storage driver %q has no migrations", cfg.StorageDriver)
+	}
 }
 
-func New(db *mongo.Database, log logger.Logger) StorageI {
-	return &storagePg{productService: mon.NewProductRepo(db, log)}
+// migrateOnStart brings the schema up to date when cfg.MigrateOnStart is set
+func migrateOnStart(cfg config.Config, log logger.Logger, driver migrate.Driver) error {
+	if !cfg.MigrateOnStart {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), migrateOnStartTimeout)
+	defer cancel()
+
+	if err := migrate.New(driver, log).Up(ctx); err != nil {
+		return fmt.Errorf("cannot migrate %s: %w", cfg.StorageDriver, err)
+	}
+
+	return nil
 }
 
-func (s *storagePg) ProductService() repo.ProductServiceI {
+func (s *storage) ProductService() repo.ProductServiceI {
 	return s.productService
 }
+
+func (s *storage) Close() {
+	s.close()
+}